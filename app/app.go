@@ -2,10 +2,15 @@ package app
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/drawer"
+	"github.com/gofred-io/gofred-website/app/components/palette"
+	"github.com/gofred-io/gofred-website/app/components/picker"
+	"github.com/gofred-io/gofred-website/app/components/search"
+	themepicker "github.com/gofred-io/gofred-website/app/components/theme_picker"
 	notfound "github.com/gofred-io/gofred-website/app/pages/404"
 	"github.com/gofred-io/gofred-website/app/pages/docs"
 	docsDrawer "github.com/gofred-io/gofred-website/app/pages/docs/drawer"
 	"github.com/gofred-io/gofred-website/app/pages/home"
+	"github.com/gofred-io/gofred-website/app/pages/playground"
 	"github.com/gofred-io/gofred/application"
 	"github.com/gofred-io/gofred/foundation/router"
 	"github.com/gofred-io/gofred/foundation/scaffold"
@@ -13,15 +18,28 @@ import (
 )
 
 func New() application.BaseWidget {
+	search.BindShortcut()
+	palette.BindShortcut()
+
+	// Pickers are drawers of the scaffold too, opening one closes the others
+	picker.BeforeOpen = func() {
+		scaffold.Get().Drawer(drawer.Name).Hide()
+		scaffold.Get().Drawer(docsDrawer.Name).Hide()
+	}
+
 	return scaffold.New(
 		theme_provider.New(
 			router.New(
 				router.Route("/", home.New),
 				router.Route("/docs/:section", docs.New),
+				router.Route("/playground", playground.New),
 				router.NotFound(notfound.New),
 			),
 		),
 		scaffold.Drawer(drawer.New()),
 		scaffold.Drawer(docsDrawer.New()),
+		scaffold.Drawer(search.Drawer()),
+		scaffold.Drawer(palette.Drawer()),
+		scaffold.Drawer(themepicker.Drawer()),
 	)
 }
@@ -0,0 +1,144 @@
+//go:build js && wasm
+
+package app
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/internal/domtest"
+)
+
+func TestMain(m *testing.M) {
+	domtest.Start(New())
+	os.Exit(m.Run())
+}
+
+func hasLink(links []domtest.Link, want domtest.Link) bool {
+	for _, link := range links {
+		if link == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestHome(t *testing.T) {
+	domtest.Visit(t, "/")
+
+	text := domtest.Text()
+	for _, want := range []string{
+		"Build web apps with Go",
+		"Build responsive web apps in Go – no JavaScript required",
+		"Get Started",
+		"View on GitHub",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("home page does not show %q", want)
+		}
+	}
+
+	links := domtest.Links()
+	for _, want := range []domtest.Link{
+		{Text: "Get Started", Href: "/docs", Label: "docs page"},
+		{Text: "View on GitHub", Href: "https://github.com/gofred-io/gofred", Label: "GitHub page", NewTab: true},
+		{Text: "View Full Tutorial", Href: "/docs/first-app", Label: "full tutorial"},
+		{Text: "License", Href: "https://github.com/gofred-io/gofred/blob/main/LICENSE", Label: "License", NewTab: true},
+	} {
+		if !hasLink(links, want) {
+			t.Errorf("home page has no link %+v", want)
+		}
+	}
+
+	if len(domtest.Labelled("Choose theme")) != 1 {
+		t.Error("home page has no theme button")
+	}
+}
+
+func TestDocsPage(t *testing.T) {
+	domtest.Visit(t, "/docs/installation")
+
+	text := domtest.Text()
+	for _, want := range []string{
+		"Installation",
+		"Install the gofred CLI tool and set up your development environment",
+		"Prerequisites",
+		"Verify Installation",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("installation page does not show %q", want)
+		}
+	}
+
+	// The first page only links forward
+	links := domtest.Links()
+	if !hasLink(links, domtest.Link{Text: "Next Quick Start", Href: "/docs/quick-start", Label: "Next page: Quick Start"}) {
+		t.Errorf("installation page has no link to the next page in %+v", links)
+	}
+	for _, link := range links {
+		if strings.HasPrefix(link.Label, "Previous page") {
+			t.Errorf("first page links back with %+v", link)
+		}
+	}
+}
+
+func TestHeadingAnchor(t *testing.T) {
+	// The Core Concepts pages are written by hand, not generated from Markdown
+	domtest.Visit(t, "/docs/state#hooks-usestate")
+
+	headings := domtest.All("#hooks-usestate")
+	if len(headings) != 1 {
+		t.Fatalf("%d elements with the ID hooks-usestate, want the heading", len(headings))
+	}
+	if got := strings.TrimSpace(headings[0].Get("textContent").String()); got != "Hooks & UseState" {
+		t.Errorf("hooks-usestate is the ID of %q, want the heading Hooks & UseState", got)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	for _, path := range []string{"/no-such-page", "/docs/no-such-page"} {
+		domtest.Visit(t, path)
+
+		text := domtest.Text()
+		if !strings.Contains(text, "404") || !strings.Contains(text, "Oops! The page you're looking for doesn't exist.") {
+			t.Errorf("%s does not show the not found page: %q", path, text)
+		}
+		if !hasLink(domtest.Links(), domtest.Link{Text: "Go Home", Href: "/", Label: "home page"}) {
+			t.Errorf("%s has no link home", path)
+		}
+		if back := domtest.Labelled("Go Back"); len(back) != 1 || !domtest.Visible(back[0]) {
+			t.Errorf("%s has no visible Go Back button", path)
+		}
+	}
+}
+
+func TestPaletteVisibility(t *testing.T) {
+	domtest.Visit(t, "/")
+
+	const label = "Type a command or page"
+	shown := func() bool {
+		for _, dialog := range domtest.Labelled(label) {
+			if domtest.Visible(dialog) {
+				return true
+			}
+		}
+		return false
+	}
+	if shown() {
+		t.Fatal("palette shown before it is opened")
+	}
+
+	domtest.Press(t, "k", "ctrl")
+	if !shown() {
+		t.Fatal("Ctrl+K does not show the palette")
+	}
+	if !strings.Contains(domtest.Text(), "Use system theme") && !strings.Contains(domtest.Text(), "Installation") {
+		t.Error("palette lists no commands")
+	}
+
+	domtest.Press(t, "Escape")
+	if shown() {
+		t.Error("Escape does not hide the palette")
+	}
+}
@@ -0,0 +1,263 @@
+// Package browser wraps the few DOM APIs the site needs that gofred does not
+// expose as widgets, like text fields and page-wide keyboard shortcuts.
+package browser
+
+import (
+	"syscall/js"
+	"time"
+)
+
+// KeyEvent is a keydown event
+type KeyEvent struct {
+	Key   string
+	Ctrl  bool
+	Meta  bool
+	Shift bool
+	Alt   bool
+
+	// Editing is set when the key was pressed inside a text field
+	Editing bool
+
+	event js.Value
+}
+
+// PreventDefault stops the browser's own handling of the key
+func (e KeyEvent) PreventDefault() {
+	e.event.Call("preventDefault")
+}
+
+// Document returns the page's document
+func Document() js.Value {
+	return js.Global().Get("document")
+}
+
+// Root returns the element the app renders into, which is also the element
+// that scrolls
+func Root() js.Value {
+	return Document().Call("getElementById", "root")
+}
+
+// AfterRender calls fn in the next animation frame, once the widgets built
+// in this frame are in the DOM
+func AfterRender(fn func()) {
+	var callback js.Func
+	callback = js.FuncOf(func(this js.Value, args []js.Value) any {
+		callback.Release()
+		fn()
+		return nil
+	})
+	js.Global().Call("requestAnimationFrame", callback)
+}
+
+// After calls fn once d has passed and returns a function that cancels the
+// call if it has not happened yet
+func After(d time.Duration, fn func()) (cancel func()) {
+	done := false
+	var callback js.Func
+	callback = js.FuncOf(func(this js.Value, args []js.Value) any {
+		done = true
+		callback.Release()
+		fn()
+		return nil
+	})
+	id := js.Global().Call("setTimeout", callback, d.Milliseconds())
+
+	return func() {
+		if done {
+			return
+		}
+		done = true
+		js.Global().Call("clearTimeout", id)
+		callback.Release()
+	}
+}
+
+// Path returns the path of the current URL
+func Path() string {
+	return js.Global().Get("location").Get("pathname").String()
+}
+
+// Hash returns the fragment of the current URL without the leading #
+func Hash() string {
+	hash := js.Global().Get("location").Get("hash").String()
+	if len(hash) > 0 && hash[0] == '#' {
+		hash = hash[1:]
+	}
+	return hash
+}
+
+// Location returns the full URL of the current page
+func Location() string {
+	return js.Global().Get("location").Get("href").String()
+}
+
+// Origin returns the scheme, host and port of the current page, like
+// "https://gofred.io"
+func Origin() string {
+	return js.Global().Get("location").Get("origin").String()
+}
+
+// OpenTab opens href in a new browser tab
+func OpenTab(href string) {
+	js.Global().Call("open", href, "_blank", "noopener")
+}
+
+// CopyText writes text to the clipboard and calls done with whether it worked
+func CopyText(text string, done func(ok bool)) {
+	clipboard := js.Global().Get("navigator").Get("clipboard")
+	if !clipboard.Truthy() {
+		done(false)
+		return
+	}
+
+	var resolved, rejected js.Func
+	settle := func(ok bool) {
+		resolved.Release()
+		rejected.Release()
+		done(ok)
+	}
+	resolved = js.FuncOf(func(this js.Value, args []js.Value) any {
+		settle(true)
+		return nil
+	})
+	rejected = js.FuncOf(func(this js.Value, args []js.Value) any {
+		settle(false)
+		return nil
+	})
+	clipboard.Call("writeText", text).Call("then", resolved, rejected)
+}
+
+// Await calls done with the value promise resolves to, or the error it is
+// rejected with
+func Await(promise js.Value, done func(value js.Value, err error)) {
+	var resolved, rejected js.Func
+	resolved = js.FuncOf(func(this js.Value, args []js.Value) any {
+		resolved.Release()
+		rejected.Release()
+		done(args[0], nil)
+		return nil
+	})
+	rejected = js.FuncOf(func(this js.Value, args []js.Value) any {
+		resolved.Release()
+		rejected.Release()
+		done(js.Undefined(), js.Error{Value: args[0]})
+		return nil
+	})
+	promise.Call("then", resolved, rejected)
+}
+
+// Env returns a setting of the window.env object env.js defines, or "" when
+// it is not set
+func Env(key string) string {
+	env := js.Global().Get("env")
+	if !env.Truthy() {
+		return ""
+	}
+	value := env.Get(key)
+	if value.Type() != js.TypeString {
+		return ""
+	}
+	return value.String()
+}
+
+// OnKeyDown calls handler for every key pressed on the page and returns a
+// function that removes it again
+func OnKeyDown(handler func(KeyEvent)) func() {
+	listener := js.FuncOf(func(this js.Value, args []js.Value) any {
+		handler(newKeyEvent(args[0]))
+		return nil
+	})
+	Document().Call("addEventListener", "keydown", listener)
+
+	return func() {
+		Document().Call("removeEventListener", "keydown", listener)
+		listener.Release()
+	}
+}
+
+// Listen adds an event listener to target for the lifetime of the page
+func Listen(target js.Value, event string, handler func(js.Value)) {
+	target.Call("addEventListener", event, js.FuncOf(func(this js.Value, args []js.Value) any {
+		handler(args[0])
+		return nil
+	}))
+}
+
+// Stored returns the value saved under key in localStorage, or "" when
+// there is none or storage is blocked, like in some private windows
+func Stored(key string) (value string) {
+	defer func() {
+		if recover() != nil {
+			value = ""
+		}
+	}()
+	item := js.Global().Get("localStorage").Call("getItem", key)
+	if item.Type() != js.TypeString {
+		return ""
+	}
+	return item.String()
+}
+
+// Store saves value under key in localStorage. Blocked storage is ignored,
+// the value then only lasts until the page is closed.
+func Store(key, value string) {
+	defer func() {
+		recover()
+	}()
+	js.Global().Get("localStorage").Call("setItem", key, value)
+}
+
+// MatchMedia reports whether the media query matches, like
+// "(prefers-color-scheme: dark)"
+func MatchMedia(query string) bool {
+	return js.Global().Call("matchMedia", query).Get("matches").Bool()
+}
+
+// OnMediaChange calls handler whenever the media query starts or stops
+// matching, for the lifetime of the page
+func OnMediaChange(query string, handler func(matches bool)) {
+	Listen(js.Global().Call("matchMedia", query), "change", func(event js.Value) {
+		handler(event.Get("matches").Bool())
+	})
+}
+
+// SetDocumentData sets a data- attribute on the <html> element, for CSS
+// outside the widgets like web/index.css
+func SetDocumentData(key, value string) {
+	Document().Get("documentElement").Get("dataset").Set(key, value)
+}
+
+// SetDocumentStyle sets a CSS property, like a --custom-property, on the
+// <html> element
+func SetDocumentStyle(property, value string) {
+	Document().Get("documentElement").Get("style").Call("setProperty", property, value)
+}
+
+// Element creates an element with the given class names
+func Element(tag, className string) js.Value {
+	element := Document().Call("createElement", tag)
+	if className != "" {
+		element.Set("className", className)
+	}
+	return element
+}
+
+func newKeyEvent(event js.Value) KeyEvent {
+	target := event.Get("target")
+	editing := false
+	if target.Truthy() {
+		tag := target.Get("tagName")
+		editing = target.Get("isContentEditable").Truthy() ||
+			(tag.Truthy() && (tag.String() == "INPUT" || tag.String() == "TEXTAREA" || tag.String() == "SELECT"))
+	}
+
+	return KeyEvent{
+		Key:     event.Get("key").String(),
+		Ctrl:    event.Get("ctrlKey").Bool(),
+		Meta:    event.Get("metaKey").Bool(),
+		Shift:   event.Get("shiftKey").Bool(),
+		Alt:     event.Get("altKey").Bool(),
+		Editing: editing,
+		event:   event,
+	}
+}
@@ -1,19 +1,144 @@
+// Package codeblock shows code samples with a copy button, syntax colours
+// and optionally a title, line numbers, highlighted lines and diff markers,
+// alone or as tabs of a group. Go programs, and fragments declaring a widget
+// function, get a Run link opening them in the playground.
+//
+// gofred's code block renders the code as plain text, so the rest is added
+// to its header and <pre> after rendering, styled by the .code-* and
+// .syntax-* rules in web/index.css. The syntax rules use the --syntax-*
+// properties the theme sets, so blocks keep their spans when the theme
+// changes.
 package codeblock
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/gofred-io/gofred-website/app/components/snackbar"
 	"github.com/gofred-io/gofred-website/app/constant"
+	"github.com/gofred-io/gofred-website/app/highlight"
 	"github.com/gofred-io/gofred-website/app/theme"
 	"github.com/gofred-io/gofred/application"
 	codeblock "github.com/gofred-io/gofred/foundation/code_block"
+	"github.com/gofred-io/gofred/hooks"
 )
 
+// Block is a code sample
+type Block struct {
+	Code string
+
+	// Language is a Markdown fence name like "go", "bash" or "yaml". Empty
+	// guesses it from the code, names package highlight does not know show
+	// the code without colours.
+	Language string
+
+	// Title is shown in the header, usually a file name like "main.go"
+	Title string
+
+	LineNumbers bool
+
+	// Highlight are the lines shown highlighted
+	Highlight []Range
+
+	// Diff marks Code as a diff: lines starting with + are added, with -
+	// removed, and the + - or space starting the others is dropped. The copy
+	// button copies the code without the removed lines.
+	Diff bool
+}
+
+// Range is a range of lines, numbered from 1, To included
+type Range struct {
+	From int
+	To   int
+}
+
+// New returns a block for code in a guessed language
 func New(code string) application.BaseWidget {
+	return Build(Block{Code: code})
+}
+
+// Build returns the block for b
+func Build(b Block) application.BaseWidget {
+	return build(b, nil)
+}
+
+// build returns the block for b, with the tabs of its group in the header
+// when it is one
+func build(b Block, tabs *tabBar) application.BaseWidget {
+	d := decoration{Block: b, language: highlight.Language(b.Language), tabs: tabs}
+	code := b.Code
+	if b.Diff {
+		d.lines, code = diff(b.Code)
+	} else if b.LineNumbers || len(b.Highlight) > 0 {
+		for _, text := range strings.Split(b.Code, "\n") {
+			d.lines = append(d.lines, line{text: text})
+		}
+	}
+	if b.Language == "" {
+		d.language = highlight.Detect(d.source())
+	}
+	if d.language == highlight.Go && !b.Diff && runnable(code) {
+		d.navigate = hooks.UseNavigate().Navigate
+	}
+	id := blockID()
+	if d.language != "" || d.Title != "" || d.lines != nil || d.tabs != nil {
+		decorate(id, d)
+	}
+
 	return codeblock.New(
 		code,
+		codeblock.ID(id),
 		codeblock.OnCopied(func(code string) {
 			snackbar.Show("Successfully copied to clipboard", constant.SnackbarTypeSuccess)
 		}),
 		codeblock.TextStyle(theme.Data().TextTheme.CodeBlockStyle.Primary),
 	)
 }
+
+// runnable reports whether the playground can run code: a package main
+// file, or a fragment without a package clause declaring a widget function
+func runnable(code string) bool {
+	for _, line := range strings.Split(code, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if strings.HasPrefix(line, "package ") {
+			return line == "package main"
+		}
+		break
+	}
+	return widgetFunc.MatchString(code)
+}
+
+// widgetFunc matches the declaration of a function the playground runs,
+// one returning a widget without taking arguments, which fragments without
+// a package clause may have
+var widgetFunc = regexp.MustCompile(`(?m)^func [\pL_][\pL\pN_]*\(\) application\.BaseWidget \{`)
+
+// line is a line of a block shown line by line
+type line struct {
+	text string
+
+	// mark is '+' or '-' for the added and removed lines of a diff
+	mark byte
+}
+
+// diff splits a diff into its lines and returns the code after the change
+func diff(code string) (lines []line, after string) {
+	var kept []string
+	for _, text := range strings.Split(code, "\n") {
+		var mark byte
+		if text != "" && strings.IndexByte("+- ", text[0]) >= 0 {
+			if text[0] != ' ' {
+				mark = text[0]
+			}
+			text = text[1:]
+		}
+		lines = append(lines, line{text: text, mark: mark})
+		if mark != '-' {
+			kept = append(kept, text)
+		}
+	}
+	return lines, strings.Join(kept, "\n")
+}
@@ -0,0 +1,234 @@
+package codeblock
+
+import (
+	"strconv"
+	"strings"
+	"syscall/js"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/highlight"
+	"github.com/gofred-io/gofred-website/app/pages/playground/route"
+)
+
+// decoration is what is added to a rendered block
+type decoration struct {
+	Block
+	language string
+
+	// lines is set when the block is shown line by line
+	lines []line
+
+	// tabs is set for the blocks of a group
+	tabs *tabBar
+
+	// navigate is set for Go programs, which get a link opening them in
+	// the playground with it
+	navigate func(href string)
+}
+
+// source returns the code shown, removed diff lines included
+func (d decoration) source() string {
+	if d.lines == nil {
+		return d.Code
+	}
+	texts := make([]string, len(d.lines))
+	for i, l := range d.lines {
+		texts[i] = l.text
+	}
+	return strings.Join(texts, "\n")
+}
+
+func (d decoration) highlighted(number int) bool {
+	for _, r := range d.Highlight {
+		if number >= r.From && number <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
+// pending is a block built and not decorated yet
+type pending struct {
+	decoration
+
+	// id is the element ID the block was built with
+	id string
+
+	// stale is set on the entries left after a frame, they are dropped
+	// after the next one
+	stale bool
+}
+
+var (
+	// decorations are what is added to the blocks built, found by the
+	// element ID each block is built with and deleted once applied
+	decorations []pending
+	blockIDs    int
+	scheduled   bool
+)
+
+// blockID returns the element ID of a new block
+func blockID() string {
+	blockIDs++
+	return "code-block-" + strconv.Itoa(blockIDs)
+}
+
+// decorate adds d to the block built with id once it is rendered. Blocks
+// built in the same frame are decorated together.
+func decorate(id string, d decoration) {
+	decorations = append(decorations, pending{decoration: d, id: id})
+	if scheduled {
+		return
+	}
+	scheduled = true
+	browser.AfterRender(func() {
+		scheduled = false
+		decorateAll()
+	})
+}
+
+// decorateAll decorates the blocks of decorations that are on the page
+func decorateAll() {
+	document := browser.Document()
+	kept := decorations[:0]
+	for _, d := range decorations {
+		if block := document.Call("getElementById", d.id); !block.IsNull() {
+			if pre := block.Call("querySelector", ".gf-code-block-pre"); !pre.IsNull() {
+				apply(pre, d.decoration)
+			}
+			continue
+		}
+		// Entries of blocks that were built but never shown, like those of
+		// a group rebuilt twice in a frame, don't stay around
+		if !d.stale {
+			d.stale = true
+			kept = append(kept, d)
+		}
+	}
+	clear(decorations[len(kept):])
+	decorations = kept
+}
+
+// apply adds d to the rendered block of pre
+func apply(pre js.Value, d decoration) {
+	switch {
+	case d.tabs != nil:
+		addTabs(pre, d.tabs)
+	case d.Title != "":
+		addTitle(pre, d.Title)
+	}
+	if d.navigate != nil {
+		addRun(pre, d.source(), d.navigate)
+	}
+	pre.Set("textContent", "")
+	tokens := highlight.Tokens(d.language, d.source())
+	if d.lines == nil {
+		appendTokens(pre, tokens)
+		return
+	}
+	appendLines(pre, d, tokens)
+}
+
+// addTitle puts title at the start of the header holding the copy button
+func addTitle(pre js.Value, title string) {
+	header := pre.Get("parentElement").Call("querySelector", ".gf-code-block-header")
+	if header.IsNull() {
+		return
+	}
+	element := browser.Element("span", "code-block-title")
+	element.Set("textContent", title)
+	header.Call("prepend", element)
+}
+
+// addRun puts a link opening code in the playground before the copy button
+func addRun(pre js.Value, code string, navigate func(href string)) {
+	header := pre.Get("parentElement").Call("querySelector", ".gf-code-block-header")
+	if header.IsNull() {
+		return
+	}
+	href := route.Href(code)
+	link := browser.Element("a", "code-block-run")
+	link.Set("href", href)
+	link.Set("textContent", "Run")
+	link.Set("title", "Run in the playground")
+	link.Call("setAttribute", "aria-label", "Run in the playground")
+	browser.Listen(link, "click", func(event js.Value) {
+		// Modified clicks open a new tab like other links
+		if event.Get("ctrlKey").Bool() || event.Get("metaKey").Bool() || event.Get("shiftKey").Bool() || event.Get("button").Int() != 0 {
+			return
+		}
+		event.Call("preventDefault")
+		navigate(href)
+	})
+	header.Call("insertBefore", link, header.Get("lastElementChild"))
+}
+
+func appendTokens(parent js.Value, tokens []highlight.Token) {
+	for _, token := range tokens {
+		if token.Text == "" {
+			continue
+		}
+		if token.Kind == highlight.Plain {
+			parent.Call("append", token.Text)
+			continue
+		}
+		span := browser.Element("span", "syntax-"+token.Kind.String())
+		span.Set("textContent", token.Text)
+		parent.Call("append", span)
+	}
+}
+
+// appendLines adds a block element per line, with its number and diff
+// marker in front. Numbers and markers can't be selected, so selecting the
+// code copies the code only.
+func appendLines(pre js.Value, d decoration, tokens []highlight.Token) {
+	lines := browser.Element("span", "code-lines")
+	pre.Call("append", lines)
+
+	// Tokens like block comments span lines, they are split at newlines
+	perLine := make([][]highlight.Token, len(d.lines))
+	n := 0
+	for _, token := range tokens {
+		for i, text := range strings.Split(token.Text, "\n") {
+			if i > 0 {
+				n++
+			}
+			if n < len(perLine) {
+				perLine[n] = append(perLine[n], highlight.Token{Kind: token.Kind, Text: text})
+			}
+		}
+	}
+
+	width := len(strconv.Itoa(len(d.lines)))
+	for i, l := range d.lines {
+		element := browser.Element("span", "code-line")
+		switch l.mark {
+		case '+':
+			element.Get("classList").Call("add", "code-line--added")
+		case '-':
+			element.Get("classList").Call("add", "code-line--removed")
+		}
+		if d.highlighted(i + 1) {
+			element.Get("classList").Call("add", "code-line--highlighted")
+		}
+
+		if d.LineNumbers {
+			number := browser.Element("span", "code-line-number")
+			number.Set("textContent", strings.Repeat(" ", width-len(strconv.Itoa(i+1)))+strconv.Itoa(i+1))
+			number.Call("setAttribute", "aria-hidden", "true")
+			element.Call("append", number)
+		}
+		if d.Diff {
+			marker := browser.Element("span", "code-line-marker")
+			marker.Set("textContent", " ")
+			if l.mark != 0 {
+				marker.Set("textContent", string(l.mark))
+			}
+			marker.Call("setAttribute", "aria-hidden", "true")
+			element.Call("append", marker)
+		}
+
+		appendTokens(element, perLine[i])
+		lines.Call("append", element)
+	}
+}
@@ -0,0 +1,173 @@
+package codeblock
+
+import (
+	"strconv"
+	"strings"
+	"syscall/js"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/hooks"
+	"github.com/gofred-io/gofred/listenable"
+)
+
+// Tab is a variant of a sample in a Group, like the install command for
+// one operating system
+type Tab struct {
+	// Label names the tab, like "macOS". Picking a tab shows the tabs with
+	// the same label in every group.
+	Label string
+
+	// Block is the code of the tab. Its Title is not shown, the labels take
+	// the place of the title.
+	Block
+}
+
+const (
+	// tabsKey is the localStorage key of the labels picked, the last first
+	tabsKey = "code-tabs"
+
+	// remembered is the number of labels kept
+	remembered = 8
+)
+
+var (
+	picked, setPicked = hooks.UseState([]string(nil))
+
+	// tabIDs numbers the tabs and panels for their aria attributes
+	tabIDs int
+)
+
+func init() {
+	if stored := browser.Stored(tabsKey); stored != "" {
+		setPicked(strings.Split(stored, "\n"))
+	}
+}
+
+// group is a Group widget, kept across its rebuilds
+type group struct {
+	tabs []Tab
+
+	// focus is set when a tab was picked with the keyboard, the new tab
+	// gets the focus once the group is rebuilt
+	focus bool
+}
+
+// tabBar is the header of a block shown by a group
+type tabBar struct {
+	group    *group
+	selected int
+}
+
+// Group shows one of tabs at a time, with their labels in the header of the
+// block. It shows the tab whose label was picked last in any group, on any
+// page and in earlier visits, or else the first one. Arrow keys, Home and
+// End move between the tabs.
+func Group(tabs ...Tab) application.BaseWidget {
+	g := &group{tabs: tabs}
+	return listenable.Builder(picked, func() application.BaseWidget {
+		selected := g.selected()
+		return build(g.tabs[selected].Block, &tabBar{group: g, selected: selected})
+	})
+}
+
+// selected returns the index of the tab with the label picked last
+func (g *group) selected() int {
+	for _, label := range picked.Value() {
+		for i, tab := range g.tabs {
+			if tab.Label == label {
+				return i
+			}
+		}
+	}
+	return 0
+}
+
+// pick shows the tabs labelled label and remembers the choice
+func pick(label string) {
+	labels := []string{label}
+	for _, l := range picked.Value() {
+		if l != label && len(labels) < remembered {
+			labels = append(labels, l)
+		}
+	}
+	setPicked(labels)
+	browser.Store(tabsKey, strings.Join(labels, "\n"))
+}
+
+// addTabs puts a tab list at the start of the header holding the copy
+// button and makes pre its panel
+func addTabs(pre js.Value, bar *tabBar) {
+	header := pre.Get("parentElement").Call("querySelector", ".gf-code-block-header")
+	if header.IsNull() {
+		return
+	}
+
+	tabIDs++
+	id := "code-tabs-" + strconv.Itoa(tabIDs)
+	list := browser.Element("div", "code-tabs")
+	list.Call("setAttribute", "role", "tablist")
+
+	var selected js.Value
+	for i, tab := range bar.group.tabs {
+		element := browser.Element("button", "code-tab")
+		element.Set("type", "button")
+		element.Set("id", id+"-"+strconv.Itoa(i))
+		element.Set("textContent", tab.Label)
+		element.Call("setAttribute", "role", "tab")
+		element.Call("setAttribute", "aria-controls", id+"-panel")
+		element.Call("setAttribute", "aria-selected", strconv.FormatBool(i == bar.selected))
+		// Only the selected tab is in the tab order, arrows reach the others
+		element.Set("tabIndex", -1)
+		if i == bar.selected {
+			element.Set("tabIndex", 0)
+			selected = element
+		}
+
+		label := tab.Label
+		browser.Listen(element, "click", func(js.Value) {
+			if i != bar.selected {
+				pick(label)
+			}
+		})
+		browser.Listen(element, "keydown", func(event js.Value) {
+			next := bar.next(event.Get("key").String())
+			if next < 0 {
+				return
+			}
+			event.Call("preventDefault")
+			if next != bar.selected {
+				bar.group.focus = true
+				pick(bar.group.tabs[next].Label)
+			}
+		})
+		list.Call("append", element)
+	}
+	header.Call("prepend", list)
+
+	pre.Set("id", id+"-panel")
+	pre.Call("setAttribute", "role", "tabpanel")
+	pre.Call("setAttribute", "aria-labelledby", id+"-"+strconv.Itoa(bar.selected))
+
+	if bar.group.focus {
+		bar.group.focus = false
+		selected.Call("focus")
+	}
+}
+
+// next returns the index of the tab key moves to, or -1 for other keys
+func (bar *tabBar) next(key string) int {
+	count := len(bar.group.tabs)
+	switch key {
+	case "ArrowRight":
+		return (bar.selected + 1) % count
+	case "ArrowLeft":
+		return (bar.selected + count - 1) % count
+	case "Home":
+		return 0
+	case "End":
+		return count - 1
+	}
+	return -1
+}
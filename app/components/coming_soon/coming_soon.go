@@ -85,12 +85,12 @@ func comingSoonIcon() application.BaseWidget {
 			icondata.Clock,
 			icon.Width(breakpoint.All(80)),
 			icon.Height(breakpoint.All(80)),
-			icon.Fill("#9CA3AF"),
+			icon.Fill(appTheme.Colors().Subtle),
 		),
 		container.Padding(breakpoint.All(spacing.All(20))),
-		container.BackgroundColor("#F9FAFB"),
+		container.BackgroundColor(appTheme.Colors().Surface),
 		container.BorderRadius(40),
-		container.BorderColor("#E5E7EB"),
+		container.BorderColor(appTheme.Colors().SurfaceBorder),
 		container.BorderWidth(spacing.All(1)),
 		container.BorderStyle(theme.BorderStyleTypeSolid),
 	)
@@ -113,7 +113,7 @@ func comingSoonMessage() application.BaseWidget {
 							icondata.Alert,
 							icon.Width(breakpoint.All(16)),
 							icon.Height(breakpoint.All(16)),
-							icon.Fill("#3B82F6"),
+							icon.Fill(appTheme.Colors().Info),
 						),
 						text.New(
 							"Want to contribute? This documentation is open source and we welcome contributions!",
@@ -125,9 +125,9 @@ func comingSoonMessage() application.BaseWidget {
 					row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
 				),
 				container.Padding(breakpoint.All(spacing.All(12))),
-				container.BackgroundColor("#EFF6FF"),
+				container.BackgroundColor(appTheme.Colors().AccentSurface),
 				container.BorderRadius(8),
-				container.BorderColor("#DBEAFE"),
+				container.BorderColor(appTheme.Colors().AccentBorder),
 				container.BorderWidth(spacing.All(1)),
 				container.BorderStyle(theme.BorderStyleTypeSolid),
 			),
@@ -212,7 +212,7 @@ func suggestionCard(suggestion Suggestion) application.BaseWidget {
 						icondata.ChevronRight,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#9CA3AF"),
+						icon.Fill(appTheme.Colors().Subtle),
 					),
 				},
 				row.Gap(12),
@@ -249,7 +249,7 @@ func comingSoonActions() application.BaseWidget {
 										icondata.Home,
 										icon.Width(breakpoint.All(16)),
 										icon.Height(breakpoint.All(16)),
-										icon.Fill("#FFFFFF"),
+										icon.Fill(appTheme.Colors().OnPrimary),
 									),
 									text.New(
 										"Back to Docs",
@@ -275,7 +275,7 @@ func comingSoonActions() application.BaseWidget {
 										icondata.AccountGroup,
 										icon.Width(breakpoint.All(16)),
 										icon.Height(breakpoint.All(16)),
-										icon.Fill("#FFFFFF"),
+										icon.Fill(appTheme.Colors().OnPrimary),
 									),
 									text.New(
 										"GitHub Discussions",
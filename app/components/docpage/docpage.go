@@ -0,0 +1,395 @@
+package docpage
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/icon"
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+	"github.com/gofred-io/gofred/foundation/link"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/spacer"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+	"github.com/gofred-io/gofred/theme/theme_style"
+)
+
+// SpanKind identifies how a run of inline text is styled
+type SpanKind int
+
+const (
+	SpanText SpanKind = iota
+	SpanCode
+	SpanLink
+	SpanStrong
+	SpanEmphasis
+)
+
+// Span is a run of inline text inside a description, paragraph or list item
+type Span struct {
+	Kind SpanKind
+	Text string
+	Href string
+}
+
+// Spans is the content of a single list item
+type Spans []Span
+
+// Term is an entry of a term list
+type Term struct {
+	Term        string
+	Description string
+}
+
+// Step is a linked card of a next steps list
+type Step struct {
+	Title       string
+	Description string
+	Href        string
+}
+
+func Text(value string) Span {
+	return Span{Kind: SpanText, Text: value}
+}
+
+func Code(value string) Span {
+	return Span{Kind: SpanCode, Text: value}
+}
+
+func Strong(value string) Span {
+	return Span{Kind: SpanStrong, Text: value}
+}
+
+func Emphasis(value string) Span {
+	return Span{Kind: SpanEmphasis, Text: value}
+}
+
+func Link(label, href string) Span {
+	return Span{Kind: SpanLink, Text: label, Href: href}
+}
+
+// Page lays out a docs page with its header above the body widgets
+func Page(header application.BaseWidget, body ...application.BaseWidget) application.BaseWidget {
+	return container.New(
+		column.New(
+			[]application.BaseWidget{
+				header,
+				spacer.New(spacer.Height(24)),
+				column.New(
+					body,
+					column.Gap(16),
+				),
+			},
+			column.Gap(16),
+			column.Flex(1),
+		),
+		container.Flex(1),
+		container.Padding(breakpoint.All(spacing.All(32))),
+	)
+}
+
+func Header(title string, description ...Span) application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			text.New(
+				title,
+				text.FontSize(32),
+				text.FontWeight("700"),
+			),
+			spans(description, appTheme.Data().TextTheme.TextStyle.Secondary, 18),
+		},
+		column.Gap(8),
+	)
+}
+
+func Section(title string, description ...Span) application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			heading(title, 24),
+			spans(description, appTheme.Data().TextTheme.TextStyle.Secondary, 16),
+		},
+		column.Gap(8),
+	)
+}
+
+func Subsection(title string, description ...Span) application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			heading(title, 20),
+			spans(description, appTheme.Data().TextTheme.TextStyle.Secondary, 14),
+		},
+		column.Gap(4),
+	)
+}
+
+// HeadingHref is the href of the link a section or subsection title is
+// wrapped in. Titles repeated on a page share it.
+func HeadingHref(title string) string {
+	return "#" + search.Anchor(title)
+}
+
+// heading is the title of a section or subsection. It links to its own
+// anchor, which is also how package toc finds it in the DOM: gofred cannot
+// set element IDs, but the href is in the markup from the start.
+func heading(title string, size int) application.BaseWidget {
+	return link.New(
+		text.New(
+			title,
+			text.FontSize(size),
+			text.FontWeight("700"),
+		),
+		link.Href(HeadingHref(title)),
+	)
+}
+
+func Paragraph(content ...Span) application.BaseWidget {
+	return spans(content, appTheme.Data().TextTheme.TextStyle.Secondary, 14)
+}
+
+func CheckList(items ...Spans) application.BaseWidget {
+	var listItems []application.BaseWidget
+	for _, item := range items {
+		listItems = append(listItems, listItem(checkIcon(), item))
+	}
+
+	return column.New(
+		listItems,
+		column.Gap(8),
+	)
+}
+
+func OrderedList(start int, items ...Spans) application.BaseWidget {
+	var listItems []application.BaseWidget
+	for i, item := range items {
+		marker := text.New(
+			strconv.Itoa(start+i)+".",
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+			text.FontSize(16),
+			text.FontWeight("700"),
+		)
+		listItems = append(listItems, listItem(marker, item))
+	}
+
+	return column.New(
+		listItems,
+		column.Gap(8),
+	)
+}
+
+func TermList(terms ...Term) application.BaseWidget {
+	var termItems []application.BaseWidget
+	for _, term := range terms {
+		termItems = append(termItems, termItem(term))
+	}
+
+	return column.New(
+		termItems,
+		column.Gap(12),
+	)
+}
+
+func NextSteps(steps ...Step) application.BaseWidget {
+	var stepItems []application.BaseWidget
+	for _, step := range steps {
+		stepItems = append(stepItems, nextStepItem(step))
+	}
+
+	return column.New(
+		stepItems,
+		column.Gap(12),
+	)
+}
+
+// spans renders a run of inline text. Plain runs become a single text widget,
+// mixed runs become a row so code and links keep their own styling.
+func spans(content []Span, textStyle theme_style.TextStyle, fontSize int) application.BaseWidget {
+	if len(content) == 0 {
+		return spacer.New(spacer.Height(0))
+	}
+
+	if len(content) == 1 && content[0].Kind == SpanStrong {
+		return text.New(
+			content[0].Text,
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Primary),
+			text.FontSize(fontSize),
+			text.FontWeight("700"),
+		)
+	}
+
+	if isPlain(content) {
+		var builder strings.Builder
+		for _, span := range content {
+			builder.WriteString(span.Text)
+		}
+
+		return text.New(
+			builder.String(),
+			text.TextStyle(textStyle),
+			text.FontSize(fontSize),
+		)
+	}
+
+	var spanWidgets []application.BaseWidget
+	for _, span := range content {
+		spanWidgets = append(spanWidgets, spanWidget(span, textStyle, fontSize))
+	}
+
+	return row.New(
+		spanWidgets,
+		row.Gap(0),
+		row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+	)
+}
+
+func spanWidget(span Span, textStyle theme_style.TextStyle, fontSize int) application.BaseWidget {
+	switch span.Kind {
+	case SpanCode:
+		return container.New(
+			text.New(
+				span.Text,
+				text.TextStyle(appTheme.Data().TextTheme.CodeBlockStyle.Primary),
+				text.FontSize(fontSize-2),
+			),
+			container.ContainerStyle(appTheme.Data().BoxTheme.CodeBlockStyle.Primary),
+			container.Padding(breakpoint.All(spacing.Axis(6, 2))),
+			container.BorderRadius(4),
+		)
+	case SpanLink:
+		return link.New(
+			text.New(
+				span.Text,
+				text.FontSize(fontSize),
+				text.FontColor(appTheme.Colors().Accent),
+				text.FontWeight("500"),
+			),
+			link.Href(span.Href),
+			link.NewTab(isExternal(span.Href)),
+			link.Label(span.Text),
+		)
+	case SpanStrong:
+		return text.New(
+			span.Text,
+			text.TextStyle(textStyle),
+			text.FontSize(fontSize),
+			text.FontWeight("700"),
+		)
+	default:
+		return text.New(
+			span.Text,
+			text.TextStyle(textStyle),
+			text.FontSize(fontSize),
+		)
+	}
+}
+
+func isPlain(content []Span) bool {
+	for _, span := range content {
+		if span.Kind != SpanText && span.Kind != SpanEmphasis {
+			return false
+		}
+	}
+	return true
+}
+
+func isExternal(href string) bool {
+	return strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://")
+}
+
+func checkIcon() application.BaseWidget {
+	return icon.New(
+		icondata.Check,
+		icon.Width(breakpoint.All(16)),
+		icon.Height(breakpoint.All(16)),
+		icon.Fill(appTheme.Colors().Success),
+	)
+}
+
+func listItem(marker application.BaseWidget, content Spans) application.BaseWidget {
+	return row.New(
+		[]application.BaseWidget{
+			marker,
+			spacer.New(spacer.Width(8)),
+			spans(content, appTheme.Data().TextTheme.TextStyle.Primary, 16),
+		},
+		row.Gap(8),
+		row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+	)
+}
+
+func termItem(term Term) application.BaseWidget {
+	return container.New(
+		column.New(
+			[]application.BaseWidget{
+				text.New(
+					term.Term,
+					text.FontSize(16),
+					text.FontWeight("700"),
+				),
+				text.New(
+					term.Description,
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+					text.FontSize(14),
+					text.LineHeight(1.5),
+				),
+			},
+			column.Gap(4),
+			column.Flex(1),
+		),
+		container.Padding(breakpoint.All(spacing.All(16))),
+		container.BorderRadius(8),
+		container.BorderWidth(spacing.All(1)),
+		container.BorderStyle(theme.BorderStyleTypeSolid),
+	)
+}
+
+func nextStepItem(step Step) application.BaseWidget {
+	return link.New(
+		container.New(
+			row.New(
+				[]application.BaseWidget{
+					column.New(
+						[]application.BaseWidget{
+							text.New(
+								step.Title,
+								text.TextStyle(appTheme.Data().TextTheme.TextStyle.Primary),
+								text.FontSize(16),
+								text.FontWeight("500"),
+							),
+							text.New(
+								step.Description,
+								text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+								text.FontSize(14),
+							),
+						},
+						column.Gap(4),
+						column.Flex(1),
+					),
+					icon.New(
+						icondata.ChevronRight,
+						icon.Width(breakpoint.All(20)),
+						icon.Height(breakpoint.All(20)),
+						icon.Fill(appTheme.Colors().Subtle),
+					),
+				},
+				row.Gap(12),
+				row.Flex(1),
+				row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+			),
+			container.ContainerStyle(appTheme.Data().BoxTheme.ContainerStyle.Primary),
+			container.Padding(breakpoint.All(spacing.All(16))),
+			container.BorderRadius(8),
+			container.BorderWidth(spacing.All(1)),
+			container.BorderStyle(theme.BorderStyleTypeSolid),
+		),
+		link.Href(step.Href),
+		link.Label(step.Title),
+	)
+}
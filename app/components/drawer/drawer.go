@@ -54,7 +54,7 @@ func drawerHeader() application.BaseWidget {
 				spacer.New(),
 				iconbutton.New(
 					icondata.Close,
-					iconbutton.Fill("#6B7280"),
+					iconbutton.Fill(appTheme.Colors().Muted),
 					iconbutton.OnClick(func(this application.BaseWidget, e application.Event) {
 						scaffold.Get().Drawer(Name).Hide()
 					}),
@@ -122,6 +122,7 @@ func navigationSection() application.BaseWidget {
 			navItem("Core Concepts", "/docs/core-concepts", icondata.Lightbulb, false),
 			navItem("Components", "/docs/components", icondata.Package, false),
 			navItem("API Reference", "/docs/api", icondata.FileDocument, false),
+			navItem("Playground", "/playground", icondata.Application, false),
 		},
 		column.Gap(4),
 	)
@@ -186,7 +187,7 @@ func navItem(title, href string, iconData icondata.IconData, isExternal bool) ap
 						iconData,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#6B7280"),
+						icon.Fill(appTheme.Colors().Muted),
 					),
 					text.New(
 						title,
@@ -202,7 +203,7 @@ func navItem(title, href string, iconData icondata.IconData, isExternal bool) ap
 								icondata.OpenInNew,
 								icon.Width(breakpoint.All(16)),
 								icon.Height(breakpoint.All(16)),
-								icon.Fill("#9CA3AF"),
+								icon.Fill(appTheme.Colors().Subtle),
 							)
 						}
 						return spacer.New()
@@ -236,7 +237,7 @@ func externalNavItem(title, href string, iconData icondata.IconData) application
 						iconData,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#6B7280"),
+						icon.Fill(appTheme.Colors().Muted),
 					),
 					text.New(
 						title,
@@ -250,7 +251,7 @@ func externalNavItem(title, href string, iconData icondata.IconData) application
 						icondata.OpenInNew,
 						icon.Width(breakpoint.All(16)),
 						icon.Height(breakpoint.All(16)),
-						icon.Fill("#9CA3AF"),
+						icon.Fill(appTheme.Colors().Subtle),
 					),
 				},
 				row.Gap(12),
@@ -1,6 +1,7 @@
 package footer
 
 import (
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 	"github.com/gofred-io/gofred/application"
 	"github.com/gofred-io/gofred/breakpoint"
@@ -90,26 +91,20 @@ func footerContent() application.BaseWidget {
 				[]application.BaseWidget{
 					// Quick links
 					footerLinksSection("Quick Links", []FooterLink{
-						{title: "Documentation", href: "/docs"},
-						{title: "Getting Started", href: "/docs/installation"},
-						{title: "Examples", href: "/docs/examples"},
-						{title: "API Reference", href: "/docs/api"},
+						{title: "Documentation", href: registry.Href("")},
+						{title: "Getting Started", href: registry.Get("installation").Href()},
+						docsFooterLink("examples"),
+						docsFooterLink("api"),
 					}),
 
 					// Community section
-					footerLinksSection("Community", []FooterLink{
-						{title: "GitHub", href: "https://github.com/gofred-io/gofred", newTab: true},
-						{title: "Discussions", href: "https://github.com/orgs/gofred-io/discussions", newTab: true},
-						{title: "Issues", href: "https://github.com/gofred-io/gofred/issues", newTab: true},
-						{title: "Contributions", href: "https://github.com/gofred-io/gofred/blob/main/CONTRIBUTING.md", newTab: true},
-					}),
+					footerLinksSection("Community", CommunityLinks),
 
 					// Resources section
 					footerLinksSection("Resources", []FooterLink{
-						{title: "Blog", href: "/blog"},
-						{title: "Tutorials", href: "/docs/tutorials"},
-						{title: "Best Practices", href: "/docs/best-practices"},
-						{title: "Support", href: "/docs/support"},
+						docsFooterLink("tutorials"),
+						docsFooterLink("best-practices"),
+						docsFooterLink("support"),
 					}),
 				},
 				grid.ColumnCount(
@@ -128,6 +123,28 @@ type FooterLink struct {
 	newTab bool
 }
 
+// CommunityLinks are the GitHub links of the footer's community section
+var CommunityLinks = []FooterLink{
+	{title: "GitHub", href: "https://github.com/gofred-io/gofred", newTab: true},
+	{title: "Discussions", href: "https://github.com/orgs/gofred-io/discussions", newTab: true},
+	{title: "Issues", href: "https://github.com/gofred-io/gofred/issues", newTab: true},
+	{title: "Contributions", href: "https://github.com/gofred-io/gofred/blob/main/CONTRIBUTING.md", newTab: true},
+}
+
+func (l FooterLink) Title() string {
+	return l.title
+}
+
+func (l FooterLink) Href() string {
+	return l.href
+}
+
+// docsFooterLink links to a registered docs page using its registry title
+func docsFooterLink(slug string) FooterLink {
+	page := registry.Get(slug)
+	return FooterLink{title: page.Title, href: page.Href()}
+}
+
 // Brand section with logo and description
 func footerBrandSection() application.BaseWidget {
 
@@ -240,7 +257,7 @@ func socialIcon(iconData icondata.IconData, tooltip, href string) application.Ba
 				iconData,
 				icon.Width(breakpoint.All(20)),
 				icon.Height(breakpoint.All(20)),
-				icon.Fill("#9CA3AF"),
+				icon.Fill(appTheme.Colors().OnTertiary),
 			),
 			container.Padding(breakpoint.All(spacing.All(8))),
 			container.ContainerStyle(appTheme.Data().BoxTheme.ContainerStyle.Tertiary),
@@ -272,11 +289,7 @@ func footerBottom() application.BaseWidget {
 						// Legal links
 						row.New(
 							[]application.BaseWidget{
-								footerLink("Privacy Policy", "/privacy", false),
-								text.New("•", text.TextStyle(appTheme.Data().TextTheme.TextStyle.Tertiary), text.FontSize(14)),
-								footerLink("Terms of Service", "/terms", false),
-								text.New("•", text.TextStyle(appTheme.Data().TextTheme.TextStyle.Tertiary), text.FontSize(14)),
-								footerLink("License", "/license", false),
+								footerLink("License", "https://github.com/gofred-io/gofred/blob/main/LICENSE", true),
 							},
 							row.Gap(8),
 							row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
@@ -301,7 +314,7 @@ func footerBottom() application.BaseWidget {
 							icondata.Heart,
 							icon.Width(breakpoint.All(16)),
 							icon.Height(breakpoint.All(16)),
-							icon.Fill("#EF4444"),
+							icon.Fill(appTheme.Colors().Error),
 						),
 						text.New(
 							"using gofred",
@@ -415,7 +428,7 @@ func mobileFooter() application.BaseWidget {
 											icondata.Heart,
 											icon.Width(breakpoint.All(14)),
 											icon.Height(breakpoint.All(14)),
-											icon.Fill("#EF4444"),
+											icon.Fill(appTheme.Colors().Error),
 										),
 										text.New(
 											"using gofred",
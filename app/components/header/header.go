@@ -2,6 +2,8 @@ package header
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/drawer"
+	"github.com/gofred-io/gofred-website/app/components/search"
+	themepicker "github.com/gofred-io/gofred-website/app/components/theme_picker"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -16,7 +18,6 @@ import (
 	"github.com/gofred-io/gofred/foundation/scaffold"
 	"github.com/gofred-io/gofred/foundation/spacer"
 	"github.com/gofred-io/gofred/foundation/text"
-	"github.com/gofred-io/gofred/hooks"
 	"github.com/gofred-io/gofred/listenable"
 	"github.com/gofred-io/gofred/options/spacing"
 	"github.com/gofred-io/gofred/theme"
@@ -143,6 +144,7 @@ func desktopNavigation() application.BaseWidget {
 			[]application.BaseWidget{
 				navigationLink("Documentation", "/docs", false),
 				navigationLink("Examples", "/docs/examples", false),
+				navigationLink("Playground", "/playground", false),
 				navigationLink("Community", "https://github.com/orgs/gofred-io/discussions", true),
 			},
 			row.Gap(32),
@@ -161,6 +163,8 @@ func desktopNavigation() application.BaseWidget {
 func headerActions() application.BaseWidget {
 	return row.New(
 		[]application.BaseWidget{
+			searchButton(),
+			spacer.New(spacer.Width(8)),
 			//githubButton(),
 			//spacer.New(spacer.Width(12)),
 			themeToggleButton(),
@@ -170,6 +174,33 @@ func headerActions() application.BaseWidget {
 	)
 }
 
+// Search box on wide screens, icon button on narrow ones
+func searchButton() application.BaseWidget {
+	return row.New(
+		[]application.BaseWidget{
+			container.New(
+				search.Field(),
+				container.Visible(
+					breakpoint.XS(false),
+					breakpoint.SM(false),
+					breakpoint.MD(true),
+					breakpoint.LG(true),
+				),
+			),
+			container.New(
+				search.IconButton(),
+				container.Visible(
+					breakpoint.XS(true),
+					breakpoint.SM(true),
+					breakpoint.MD(false),
+					breakpoint.LG(false),
+				),
+			),
+		},
+		row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+	)
+}
+
 // Modern navigation link
 func navigationLink(label, href string, external bool) application.BaseWidget {
 
@@ -201,7 +232,7 @@ func navigationLink(label, href string, external bool) application.BaseWidget {
 					icondata.OpenInNew,
 					icon.Width(breakpoint.All(14)),
 					icon.Height(breakpoint.All(14)),
-					icon.Fill("#6B7280"),
+					icon.Fill(appTheme.Colors().Muted),
 				),
 			},
 			row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
@@ -219,15 +250,20 @@ func navigationLink(label, href string, external bool) application.BaseWidget {
 	)
 }
 
+// themeToggleButton opens the theme picker. Its icon shows whether the
+// theme follows the system, or else whether it is light or dark.
 func themeToggleButton() application.BaseWidget {
-	themeHook, setThemeData := hooks.UseTheme()
-	return listenable.Builder(themeHook, func() application.BaseWidget {
+	return listenable.Builder(appTheme.PreferenceListenable(), func() application.BaseWidget {
+		current := appTheme.Current()
+
 		themeIcon := icondata.WhiteBalanceSunny
-		themeTooltip := "Switch to dark mode"
-		themeData := appTheme.Data()
-		if themeData.Name == string(appTheme.ThemeDark) {
+		if current.Dark {
 			themeIcon = icondata.MoonWaningCrescent
-			themeTooltip = "Switch to light mode"
+		}
+		themeTooltip := "Theme: " + current.Title
+		if appTheme.CurrentPreference() == appTheme.PreferenceSystem {
+			themeIcon = icondata.Cog
+			themeTooltip = "Theme: system (" + current.Title + ")"
 		}
 
 		return container.New(
@@ -235,14 +271,10 @@ func themeToggleButton() application.BaseWidget {
 				themeIcon,
 				iconbutton.ButtonStyle(appTheme.Data().ButtonTheme.IconButtonStyle.Secondary),
 				iconbutton.OnClick(func(this application.BaseWidget, e application.Event) {
-					if themeData.Name == string(appTheme.ThemeLight) {
-						setThemeData(appTheme.DarkTheme())
-					} else {
-						setThemeData(appTheme.LightTheme())
-					}
+					themepicker.Open()
 				}),
 				iconbutton.Tooltip(themeTooltip),
-				iconbutton.Label("Switch theme"),
+				iconbutton.Label("Choose theme"),
 			),
 			container.Padding(breakpoint.All(spacing.All(8))),
 			container.BorderRadius(8),
@@ -0,0 +1,170 @@
+package palette
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/components/footer"
+	"github.com/gofred-io/gofred-website/app/components/picker"
+	"github.com/gofred-io/gofred-website/app/components/snackbar"
+	"github.com/gofred-io/gofred-website/app/constant"
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/foundation/drawer"
+	"github.com/gofred-io/gofred/hooks"
+)
+
+// command is an entry of the palette
+type command struct {
+	title  string
+	detail string
+	hint   string
+	run    func()
+}
+
+var (
+	matches []command
+
+	// navigateTo opens the page of a Go to command
+	navigateTo func(href string)
+
+	dialog = &picker.Picker{
+		Name:   "palette",
+		Label:  "Type a command or page",
+		Query:  query,
+		Choose: choose,
+	}
+)
+
+// BindShortcut toggles the palette with Ctrl+K, or Cmd+K on macOS
+func BindShortcut() {
+	browser.OnKeyDown(func(e browser.KeyEvent) {
+		if (e.Key != "k" && e.Key != "K") || !(e.Ctrl || e.Meta) || e.Alt || e.Shift {
+			return
+		}
+		e.PreventDefault()
+		if dialog.IsOpen() {
+			dialog.Close()
+		} else {
+			dialog.Open()
+		}
+	})
+}
+
+// Drawer returns the drawer of the palette, for the scaffold in app.New
+func Drawer() (string, *drawer.Drawer) {
+	navigateTo = hooks.UseNavigate().Navigate
+	return dialog.Drawer()
+}
+
+// Open shows the palette
+func Open() {
+	dialog.Open()
+}
+
+// commands lists everything the palette can do, in the order shown for an empty query
+func commands() []command {
+	var list []command
+	if appTheme.CurrentPreference() != appTheme.PreferenceSystem {
+		list = append(list, command{title: "Use system theme", detail: "Theme", run: usePreference(appTheme.PreferenceSystem)})
+	}
+	for _, info := range appTheme.Themes() {
+		if preference := appTheme.Preference(info.Name); preference != appTheme.CurrentPreference() {
+			list = append(list, command{title: "Use " + strings.ToLower(info.Title) + " theme", detail: "Theme", run: usePreference(preference)})
+		}
+	}
+
+	list = append(list,
+		command{title: "Copy page URL", detail: "Page", run: copyLocation},
+		command{title: "Home", detail: "Go to", run: navigate("/")},
+		command{title: "Documentation", detail: "Go to", run: navigate(registry.Href(""))},
+		command{title: "Playground", detail: "Go to", run: navigate("/playground")},
+	)
+
+	for _, section := range registry.Sections() {
+		for _, page := range section.Pages {
+			detail := section.Category.Title
+			if !page.Available() {
+				detail += " · Coming soon"
+			}
+			list = append(list, command{title: page.Title, detail: detail, run: navigate(page.Href())})
+		}
+	}
+
+	for _, link := range footer.CommunityLinks {
+		list = append(list, command{title: link.Title(), detail: "GitHub", hint: "↗", run: openTab(link.Href())})
+	}
+
+	return list
+}
+
+func query(value string) []picker.Item {
+	type match struct {
+		command command
+		score   int
+		title   []search.Fragment
+	}
+
+	var found []match
+	for _, c := range commands() {
+		if value == "" {
+			found = append(found, match{command: c, title: []search.Fragment{{Text: c.title}}})
+			continue
+		}
+		if score, title, ok := search.Fuzzy(value, c.title); ok {
+			found = append(found, match{command: c, score: score, title: title})
+			continue
+		}
+		// Let "docs core" find pages by their category as well
+		if score, _, ok := search.Fuzzy(value, c.detail+" "+c.title); ok {
+			found = append(found, match{command: c, score: score - 10, title: []search.Fragment{{Text: c.title}}})
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].score > found[j].score
+	})
+
+	matches = matches[:0]
+	items := make([]picker.Item, 0, len(found))
+	for _, m := range found {
+		matches = append(matches, m.command)
+		items = append(items, picker.Item{Title: m.title, Detail: m.command.detail, Hint: m.command.hint})
+	}
+	return items
+}
+
+func choose(index int) {
+	matches[index].run()
+}
+
+func navigate(href string) func() {
+	return func() {
+		navigateTo(href)
+	}
+}
+
+func usePreference(preference appTheme.Preference) func() {
+	return func() {
+		appTheme.SetPreference(preference)
+	}
+}
+
+func openTab(href string) func() {
+	return func() {
+		browser.OpenTab(href)
+	}
+}
+
+func copyLocation() {
+	browser.CopyText(browser.Location(), func(ok bool) {
+		if ok {
+			snackbar.Show("Page URL copied to clipboard", constant.SnackbarTypeSuccess)
+		} else {
+			snackbar.Show("Could not copy the page URL", constant.SnackbarTypeError)
+		}
+	})
+}
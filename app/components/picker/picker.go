@@ -0,0 +1,334 @@
+// Package picker is the keyboard-driven list dialog behind the docs search,
+// the command palette and the theme picker.
+//
+// A picker opens as a drawer of the scaffold, so it shares the overlay of
+// the navigation drawers: their barrier, scroll lock and dismissal. Register
+// it with scaffold.Drawer(p.Drawer()) in app.New. gofred has no text field
+// widget, so the query field and the list are built with plain DOM, put into
+// the drawer when it opens and styled by the .picker-* rules in
+// web/index.css, which take the colours of the theme shown from its tokens.
+package picker
+
+import (
+	"strconv"
+	"syscall/js"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/drawer"
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+	iconbutton "github.com/gofred-io/gofred/foundation/icon_button"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/scaffold"
+	"github.com/gofred-io/gofred/foundation/spacer"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+)
+
+// Item is an entry of the list
+type Item struct {
+	Title   []search.Fragment
+	Detail  string
+	Snippet []search.Fragment
+	Hint    string
+}
+
+// Picker is a dialog with a query field above a list of items
+type Picker struct {
+	// Name is the name of the picker's drawer in the scaffold
+	Name string
+
+	// Label names the dialog for screen readers and is the field placeholder
+	Label string
+
+	// Idle is the status line shown while the query is empty and there are no items
+	Idle string
+
+	// Query returns the items for the text in the field
+	Query func(query string) []Item
+
+	// Choose is called with the index of the item picked from the last query.
+	// The picker is closed before it runs.
+	Choose func(index int)
+
+	panel  js.Value
+	input  js.Value
+	list   js.Value
+	status js.Value
+
+	items    []Item
+	selected int
+}
+
+var (
+	open *Picker
+
+	// BeforeOpen runs whenever a picker opens, to hide the other drawers of
+	// the scaffold
+	BeforeOpen func()
+)
+
+// Drawer returns the drawer the picker opens in, for scaffold.Drawer
+func (p *Picker) Drawer() (string, *drawer.Drawer) {
+	return p.Name, drawer.New(
+		p.header,
+		drawer.ID(p.drawerID()),
+		drawer.Width(breakpoint.All(480), breakpoint.XS(320)),
+		drawer.Transition(0.2),
+	)
+}
+
+// Open shows the picker with the last query selected
+func (p *Picker) Open() {
+	if open != nil && open != p {
+		open.Close()
+	}
+	if BeforeOpen != nil {
+		BeforeOpen()
+	}
+	if !p.panel.Truthy() {
+		p.build()
+	}
+
+	scaffold.Get().Drawer(p.Name).Show()
+	open = p
+
+	p.update()
+	browser.AfterRender(p.mount)
+}
+
+// Close hides the picker
+func (p *Picker) Close() {
+	scaffold.Get().Drawer(p.Name).Hide()
+	if open == p {
+		open = nil
+	}
+}
+
+// IsOpen reports whether the picker is showing
+func (p *Picker) IsOpen() bool {
+	return open == p
+}
+
+func (p *Picker) drawerID() string {
+	return p.Name + "-picker"
+}
+
+// header is the gofred part of the drawer, the label and a close button
+func (p *Picker) header() application.BaseWidget {
+	return container.New(
+		row.New(
+			[]application.BaseWidget{
+				text.New(
+					p.Label,
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Primary),
+					text.FontSize(16),
+					text.FontWeight("500"),
+					text.UserSelect(theme.UserSelectTypeNone),
+				),
+				spacer.New(),
+				iconbutton.New(
+					icondata.Close,
+					iconbutton.Fill(appTheme.Colors().Muted),
+					iconbutton.OnClick(func(this application.BaseWidget, e application.Event) {
+						p.Close()
+					}),
+					iconbutton.Label("Close"),
+				),
+			},
+			row.Gap(8),
+			row.Flex(1),
+			row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+		),
+		container.Padding(breakpoint.All(spacing.LRTB(16, 8, 8, 8))),
+	)
+}
+
+// mount puts the field and list into the drawer, which holds the header
+// only after gofred renders it, and focuses the field
+func (p *Picker) mount() {
+	host := browser.Document().Call("getElementById", p.drawerID())
+	if host.IsNull() || open != p {
+		return
+	}
+	if !host.Call("contains", p.panel).Bool() {
+		host.Call("append", p.panel)
+	}
+	p.input.Call("focus")
+	p.input.Call("select")
+}
+
+func (p *Picker) build() {
+	p.input = browser.Element("input", "picker-input")
+	p.list = browser.Element("ul", "picker-results")
+	p.status = browser.Element("div", "picker-status")
+
+	p.panel = browser.Element("div", "picker-panel")
+	p.panel.Call("setAttribute", "role", "dialog")
+	p.panel.Call("setAttribute", "aria-label", p.Label)
+
+	listID := "picker-" + strconv.Itoa(nextID())
+	p.input.Set("type", "search")
+	p.input.Set("placeholder", p.Label)
+	p.input.Call("setAttribute", "aria-label", p.Label)
+	p.input.Call("setAttribute", "aria-controls", listID)
+	p.input.Call("setAttribute", "autocomplete", "off")
+	p.list.Set("id", listID)
+	p.list.Call("setAttribute", "role", "listbox")
+
+	field := browser.Element("div", "picker-field")
+	escape := browser.Element("kbd", "picker-key")
+	escape.Set("textContent", "Esc")
+	field.Call("append", p.input, escape)
+	p.panel.Call("append", field, p.list, p.status)
+
+	browser.Listen(p.input, "input", func(js.Value) {
+		p.update()
+	})
+	browser.Listen(p.input, "keydown", p.onKey)
+	browser.Listen(p.list, "click", func(event js.Value) {
+		if index, ok := itemIndex(event); ok {
+			p.choose(index)
+		}
+	})
+	browser.Listen(p.list, "mousemove", func(event js.Value) {
+		if index, ok := itemIndex(event); ok && index != p.selected {
+			p.selectItem(index)
+		}
+	})
+}
+
+func (p *Picker) onKey(event js.Value) {
+	switch event.Get("key").String() {
+	case "ArrowDown":
+		event.Call("preventDefault")
+		if len(p.items) > 0 {
+			p.selectItem((p.selected + 1) % len(p.items))
+		}
+	case "ArrowUp":
+		event.Call("preventDefault")
+		if len(p.items) > 0 {
+			p.selectItem((p.selected - 1 + len(p.items)) % len(p.items))
+		}
+	case "Enter":
+		event.Call("preventDefault")
+		if len(p.items) > 0 {
+			p.choose(p.selected)
+		}
+	case "Escape":
+		event.Call("preventDefault")
+		p.Close()
+	}
+}
+
+// update runs the query in the field and redraws the list
+func (p *Picker) update() {
+	query := p.input.Get("value").String()
+	p.items = p.Query(query)
+	p.selected = 0
+
+	p.list.Set("textContent", "")
+	for i, item := range p.items {
+		p.list.Call("append", p.itemElement(i, item))
+	}
+
+	switch {
+	case len(p.items) > 0:
+		p.status.Set("textContent", "↑ ↓ to select, Enter to open")
+		p.selectItem(0)
+	case query == "":
+		p.status.Set("textContent", p.Idle)
+	default:
+		p.status.Set("textContent", "No results for \""+query+"\"")
+	}
+}
+
+func (p *Picker) selectItem(index int) {
+	elements := p.list.Get("children")
+	for i := 0; i < elements.Length(); i++ {
+		element := elements.Index(i)
+		element.Get("classList").Call("toggle", "picker-item--selected", i == index)
+		element.Call("setAttribute", "aria-selected", strconv.FormatBool(i == index))
+	}
+	p.selected = index
+
+	if index < elements.Length() {
+		elements.Index(index).Call("scrollIntoView", map[string]any{"block": "nearest"})
+		p.input.Call("setAttribute", "aria-activedescendant", elements.Index(index).Get("id"))
+	}
+}
+
+func (p *Picker) choose(index int) {
+	p.Close()
+	p.Choose(index)
+}
+
+func (p *Picker) itemElement(index int, item Item) js.Value {
+	element := browser.Element("li", "picker-item")
+	element.Set("id", p.list.Get("id").String()+"-"+strconv.Itoa(index))
+	element.Call("setAttribute", "role", "option")
+	element.Get("dataset").Set("index", index)
+
+	heading := browser.Element("div", "picker-item-heading")
+	title := browser.Element("div", "picker-item-title")
+	appendFragments(title, item.Title)
+	heading.Call("append", title)
+	if item.Hint != "" {
+		hint := browser.Element("span", "picker-item-hint")
+		hint.Set("textContent", item.Hint)
+		heading.Call("append", hint)
+	}
+	element.Call("append", heading)
+
+	if item.Detail != "" {
+		detail := browser.Element("div", "picker-item-detail")
+		detail.Set("textContent", item.Detail)
+		element.Call("append", detail)
+	}
+
+	if len(item.Snippet) > 0 {
+		snippet := browser.Element("div", "picker-item-snippet")
+		appendFragments(snippet, item.Snippet)
+		element.Call("append", snippet)
+	}
+
+	return element
+}
+
+// appendFragments adds the fragments as text, wrapping matches in <mark>
+func appendFragments(parent js.Value, fragments []search.Fragment) {
+	for _, fragment := range fragments {
+		if !fragment.Match {
+			parent.Call("append", fragment.Text)
+			continue
+		}
+		mark := browser.Element("mark", "")
+		mark.Set("textContent", fragment.Text)
+		parent.Call("append", mark)
+	}
+}
+
+// itemIndex returns the index of the item an event happened in
+func itemIndex(event js.Value) (int, bool) {
+	element := event.Get("target").Call("closest", ".picker-item")
+	if !element.Truthy() {
+		return 0, false
+	}
+	index, err := strconv.Atoi(element.Get("dataset").Get("index").String())
+	return index, err == nil
+}
+
+var (
+	lastID int
+)
+
+func nextID() int {
+	lastID++
+	return lastID
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"github.com/gofred-io/gofred-website/app/components/picker"
+	docsSearch "github.com/gofred-io/gofred-website/app/search"
+
+	"github.com/gofred-io/gofred/foundation/drawer"
+	"github.com/gofred-io/gofred/hooks"
+)
+
+const (
+	maxResults = 8
+)
+
+var (
+	results []docsSearch.Result
+
+	// navigate opens the page of the result chosen
+	navigate func(href string)
+
+	dialog = &picker.Picker{
+		Name:   "search",
+		Label:  "Search docs",
+		Idle:   "Search page titles, headings, prose and code samples",
+		Query:  query,
+		Choose: choose,
+	}
+)
+
+// Drawer returns the drawer of the search dialog, for the scaffold in app.New
+func Drawer() (string, *drawer.Drawer) {
+	navigate = hooks.UseNavigate().Navigate
+	return dialog.Drawer()
+}
+
+// Open shows the search dialog with the last query selected
+func Open() {
+	dialog.Open()
+}
+
+func query(value string) []picker.Item {
+	results = docsSearch.Search(value, maxResults)
+
+	items := make([]picker.Item, 0, len(results))
+	for _, result := range results {
+		item := picker.Item{
+			Title:   result.Title,
+			Snippet: result.Snippet,
+		}
+		if result.Document.Section != "" {
+			item.Detail = result.Document.Page
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func choose(index int) {
+	doc := results[index].Document
+	if doc.Anchor != "" {
+		navigate(doc.Href + "#" + doc.Anchor)
+		return
+	}
+	navigate(doc.Href)
+}
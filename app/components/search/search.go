@@ -0,0 +1,85 @@
+package search
+
+import (
+	"github.com/gofred-io/gofred-website/app/browser"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/button"
+	"github.com/gofred-io/gofred/foundation/icon"
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+	iconbutton "github.com/gofred-io/gofred/foundation/icon_button"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/spacer"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/theme"
+)
+
+const (
+	// Shortcut opens the search dialog from anywhere outside a text field
+	Shortcut = "/"
+)
+
+// BindShortcut opens the search dialog when Shortcut is pressed
+func BindShortcut() {
+	browser.OnKeyDown(func(e browser.KeyEvent) {
+		if e.Key != Shortcut || e.Editing || e.Ctrl || e.Meta || e.Alt {
+			return
+		}
+		e.PreventDefault()
+		Open()
+	})
+}
+
+// Field is a button styled as a search box that opens the search dialog
+func Field() application.BaseWidget {
+	return button.New(
+		row.New(
+			[]application.BaseWidget{
+				icon.New(
+					icondata.Magnify,
+					icon.Width(breakpoint.All(18)),
+					icon.Height(breakpoint.All(18)),
+					icon.Fill(appTheme.Colors().Muted),
+				),
+				text.New(
+					"Search docs",
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+					text.FontSize(14),
+					text.UserSelect(theme.UserSelectTypeNone),
+				),
+				spacer.New(),
+				text.New(
+					Shortcut,
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+					text.FontSize(12),
+					text.FontWeight("500"),
+					text.UserSelect(theme.UserSelectTypeNone),
+				),
+			},
+			row.Gap(8),
+			row.Flex(1),
+			row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+		),
+		button.ButtonStyle(appTheme.Data().ButtonTheme.ButtonStyle.Secondary),
+		button.Width(breakpoint.All(220)),
+		button.OnClick(func(this application.BaseWidget, e application.Event) {
+			Open()
+		}),
+		button.Label("Search docs"),
+	)
+}
+
+// IconButton opens the search dialog, for layouts without room for Field
+func IconButton() application.BaseWidget {
+	return iconbutton.New(
+		icondata.Magnify,
+		iconbutton.ButtonStyle(appTheme.Data().ButtonTheme.IconButtonStyle.Secondary),
+		iconbutton.OnClick(func(this application.BaseWidget, e application.Event) {
+			Open()
+		}),
+		iconbutton.Tooltip("Search docs"),
+		iconbutton.Label("Search docs"),
+	)
+}
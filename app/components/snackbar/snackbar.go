@@ -1,111 +1,305 @@
+// Package snackbar shows short messages stacked at the bottom left of the
+// page.
+//
+// gofred's snackbar holds one widget and replaces it on every Show, so the
+// stack is built with plain DOM and styled by the .snackbar-* rules in
+// web/index.css, like the picker. Up to MaxVisible messages show at once,
+// newer ones wait in a queue. Each message hides after its duration, which
+// pauses while the pointer or the keyboard focus is on the stack.
 package snackbar
 
 import (
+	"strconv"
+	"syscall/js"
+	"time"
+
+	"github.com/gofred-io/gofred-website/app/browser"
 	"github.com/gofred-io/gofred-website/app/constant"
-	"github.com/gofred-io/gofred/application"
-	"github.com/gofred-io/gofred/breakpoint"
-	"github.com/gofred-io/gofred/foundation/container"
-	"github.com/gofred-io/gofred/foundation/icon"
-	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
-	"github.com/gofred-io/gofred/foundation/row"
-	foundationSnackbar "github.com/gofred-io/gofred/foundation/snackbar"
-	"github.com/gofred-io/gofred/foundation/text"
-	"github.com/gofred-io/gofred/options/spacing"
-	"github.com/gofred-io/gofred/theme"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
 )
 
+const (
+	// DefaultDuration is how long a message shows when it sets no Duration
+	DefaultDuration = 4 * time.Second
+
+	// MaxVisible is the number of messages shown at once
+	MaxVisible = 3
+
+	// transition matches the .snackbar transition in web/index.css
+	transition = 200 * time.Millisecond
+)
+
+// Message is a snackbar message
+type Message struct {
+	Text string
+	Type constant.SnackbarType
+
+	// Duration is how long the message shows, DefaultDuration if zero. A
+	// negative duration keeps it until it is dismissed.
+	Duration time.Duration
+
+	// Action is the label of a button next to the text, like "Undo".
+	// Clicking it calls OnAction and dismisses the message.
+	Action   string
+	OnAction func()
+}
+
+// entry is a message that is showing or queued
+type entry struct {
+	Message
+
+	element js.Value
+	counter js.Value
+
+	// count is the number of times the message was shown while it was up
+	count int
+
+	// remaining is the time left while the timer is paused, deadline the
+	// time it hides at while it runs
+	remaining time.Duration
+	deadline  time.Time
+	stop      func()
+
+	dismissed bool
+}
+
 var (
-	snackbar foundationSnackbar.ISnackbar
+	stack   js.Value
+	visible []*entry
+	queue   []*entry
+
+	// paused is set while the pointer or the focus is on the stack
+	paused  bool
+	hovered bool
+	focused bool
 )
 
-func Get() foundationSnackbar.ISnackbar {
-	if snackbar == nil {
-		snackbar = buildSnackbar()
+// Show displays message with the default duration
+func Show(message string, snackbarType constant.SnackbarType) {
+	Push(Message{Text: message, Type: snackbarType})
+}
+
+// Push displays m, or queues it while MaxVisible messages are showing, and
+// returns a function that dismisses it. A message with the same text and
+// type as one showing restarts its timer and counts up instead of showing
+// twice, one that is already queued is dropped.
+func Push(m Message) (dismiss func()) {
+	if m.Duration == 0 {
+		m.Duration = DefaultDuration
+	}
+
+	for _, e := range visible {
+		if e.Text == m.Text && e.Type == m.Type {
+			e.repeat()
+			return e.dismiss
+		}
 	}
-	return snackbar
+	for _, e := range queue {
+		if e.Text == m.Text && e.Type == m.Type {
+			return e.dismiss
+		}
+	}
+
+	e := &entry{Message: m, count: 1, remaining: m.Duration}
+	if len(visible) < MaxVisible {
+		e.show()
+	} else {
+		queue = append(queue, e)
+	}
+	return e.dismiss
 }
 
-func buildSnackbar() foundationSnackbar.ISnackbar {
-	return foundationSnackbar.New(
-		foundationSnackbar.Transition(0.3),
-		foundationSnackbar.Position(theme.PositionTypeBottomLeft),
-	)
+// DismissAll hides every message and empties the queue
+func DismissAll() {
+	queue = nil
+	for len(visible) > 0 {
+		visible[0].dismiss()
+	}
 }
 
-// Show creates and displays a snackbar with the given message and type
-func Show(message string, snackbarType constant.SnackbarType) {
-	Get().Show(snackbarContainer(message, snackbarType))
+func (e *entry) show() {
+	if !stack.Truthy() {
+		buildStack()
+	}
+	e.build()
+	visible = append(visible, e)
+	stack.Call("append", e.element)
+
+	// Added in the next frame so the transition runs
+	browser.AfterRender(func() {
+		e.element.Get("classList").Call("add", "snackbar--visible")
+	})
+	e.resume()
 }
 
-func snackbarContainer(message string, snackbarType constant.SnackbarType) application.BaseWidget {
-	return container.New(
-		row.New(
-			[]application.BaseWidget{
-				snackbarIcon(snackbarType),
-				snackbarContent(message),
-			},
-			row.Gap(12),
-			row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
-		),
-		container.BackgroundColor(getBackgroundColor(snackbarType)),
-		container.BorderRadius(8),
-		container.Padding(breakpoint.All(spacing.All(16))),
-		container.Margin(breakpoint.All(spacing.All(16))),
-		container.BoxShadow("0 4px 12px rgba(0, 0, 0, 0.15)"),
-	)
+func (e *entry) build() {
+	colors := appTheme.Colors()
+	e.element = browser.Element("div", "snackbar")
+	e.element.Get("style").Set("backgroundColor", background(e.Type, colors))
+	e.element.Get("style").Set("color", colors.OnStatus)
+	if e.Type == constant.SnackbarTypeError {
+		e.element.Call("setAttribute", "role", "alert")
+	}
+
+	symbol := browser.Element("span", "snackbar-icon")
+	symbol.Set("textContent", icon(e.Type))
+	symbol.Call("setAttribute", "aria-hidden", "true")
+
+	message := browser.Element("span", "snackbar-text")
+	message.Set("textContent", e.Text)
+
+	e.counter = browser.Element("span", "snackbar-count")
+	e.element.Call("append", symbol, message, e.counter)
+
+	if e.Action != "" {
+		action := browser.Element("button", "snackbar-action")
+		action.Set("type", "button")
+		action.Set("textContent", e.Action)
+		browser.Listen(action, "click", func(js.Value) {
+			e.dismiss()
+			if e.OnAction != nil {
+				e.OnAction()
+			}
+		})
+		e.element.Call("append", action)
+	}
+
+	closeButton := browser.Element("button", "snackbar-close")
+	closeButton.Set("type", "button")
+	closeButton.Set("textContent", "×")
+	closeButton.Call("setAttribute", "aria-label", "Dismiss")
+	browser.Listen(closeButton, "click", func(js.Value) {
+		e.dismiss()
+	})
+	e.element.Call("append", closeButton)
 }
 
-func snackbarContent(message string) application.BaseWidget {
-	return container.New(
-		text.New(
-			message,
-			text.FontSize(14),
-			text.FontColor("#FFFFFF"),
-			text.FontWeight("500"),
-		),
-		container.BackgroundColor("#00000000"),
-		container.Flex(1),
-	)
+// repeat counts the message again and gives it its full duration
+func (e *entry) repeat() {
+	e.count++
+	e.counter.Set("textContent", "×"+strconv.Itoa(e.count))
+
+	e.pause()
+	e.remaining = e.Duration
+	e.resume()
 }
 
-func snackbarIcon(snackbarType constant.SnackbarType) application.BaseWidget {
-	iconColor := "#FFFFFF"
-	iconData := getIconData(snackbarType)
+// resume starts the timer with the remaining time, unless the stack is
+// paused or the message stays until dismissed
+func (e *entry) resume() {
+	if paused || e.stop != nil || e.Duration < 0 || e.dismissed {
+		return
+	}
+	e.deadline = time.Now().Add(e.remaining)
+	e.stop = browser.After(e.remaining, e.dismiss)
+}
 
-	return icon.New(
-		iconData,
-		icon.Width(breakpoint.All(20)),
-		icon.Height(breakpoint.All(20)),
-		icon.Fill(iconColor),
-	)
+// pause stops the timer and keeps the time left
+func (e *entry) pause() {
+	if e.stop == nil {
+		return
+	}
+	e.stop()
+	e.stop = nil
+	e.remaining = max(time.Until(e.deadline), 0)
 }
 
-func getBackgroundColor(snackbarType constant.SnackbarType) string {
+// dismiss hides the message, or drops it from the queue, and shows the
+// next queued one
+func (e *entry) dismiss() {
+	if e.dismissed {
+		return
+	}
+	e.dismissed = true
+	e.pause()
+
+	for i, queued := range queue {
+		if queued == e {
+			queue = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+	for i, shown := range visible {
+		if shown == e {
+			visible = append(visible[:i], visible[i+1:]...)
+			break
+		}
+	}
+
+	element := e.element
+	element.Get("classList").Call("remove", "snackbar--visible")
+	browser.After(transition, func() {
+		element.Call("remove")
+	})
+
+	if len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		next.show()
+	}
+}
+
+// buildStack adds the element holding the messages to the page. It is a
+// polite live region so screen readers announce new messages.
+func buildStack() {
+	stack = browser.Element("div", "snackbar-stack")
+	stack.Call("setAttribute", "role", "status")
+	stack.Call("setAttribute", "aria-live", "polite")
+	browser.Document().Get("body").Call("append", stack)
+
+	browser.Listen(stack, "mouseenter", func(js.Value) {
+		hovered = true
+		setPaused()
+	})
+	browser.Listen(stack, "mouseleave", func(js.Value) {
+		hovered = false
+		setPaused()
+	})
+	browser.Listen(stack, "focusin", func(js.Value) {
+		focused = true
+		setPaused()
+	})
+	browser.Listen(stack, "focusout", func(js.Value) {
+		focused = false
+		setPaused()
+	})
+}
+
+func setPaused() {
+	paused = hovered || focused
+	for _, e := range visible {
+		if paused {
+			e.pause()
+		} else {
+			e.resume()
+		}
+	}
+}
+
+func background(snackbarType constant.SnackbarType, colors appTheme.Tokens) string {
 	switch snackbarType {
 	case constant.SnackbarTypeSuccess:
-		return "#10B981" // Green
+		return colors.Success
 	case constant.SnackbarTypeError:
-		return "#EF4444" // Red
+		return colors.Error
 	case constant.SnackbarTypeWarning:
-		return "#F59E0B" // Amber
+		return colors.Warning
 	case constant.SnackbarTypeInfo:
-		return "#3B82F6" // Blue
+		return colors.Info
 	default:
-		return "#424242" // Gray
+		return colors.Neutral
 	}
 }
 
-func getIconData(snackbarType constant.SnackbarType) icondata.IconData {
+func icon(snackbarType constant.SnackbarType) string {
 	switch snackbarType {
 	case constant.SnackbarTypeSuccess:
-		return icondata.Check
+		return "✓"
 	case constant.SnackbarTypeError:
-		return icondata.Close
+		return "✕"
 	case constant.SnackbarTypeWarning:
-		return icondata.Alert
-	case constant.SnackbarTypeInfo:
-		return icondata.Information
+		return "!"
 	default:
-		return icondata.Information
+		return "i"
 	}
 }
@@ -0,0 +1,81 @@
+// Package themepicker is the dialog of the header's theme button, listing
+// the system setting and every theme of the registry in app/theme.
+package themepicker
+
+import (
+	"github.com/gofred-io/gofred-website/app/components/picker"
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/foundation/drawer"
+)
+
+// option is an entry of the picker
+type option struct {
+	title      string
+	detail     string
+	preference appTheme.Preference
+}
+
+var (
+	matches []option
+
+	dialog = &picker.Picker{
+		Name:   "theme",
+		Label:  "Choose a theme",
+		Query:  query,
+		Choose: choose,
+	}
+)
+
+// Drawer returns the drawer of the theme picker, for the scaffold in app.New
+func Drawer() (string, *drawer.Drawer) {
+	return dialog.Drawer()
+}
+
+// Open shows the theme picker
+func Open() {
+	dialog.Open()
+}
+
+// options lists the preferences the user can pick from, the system setting
+// first, with their titles
+func options() []option {
+	list := []option{
+		{title: "System", detail: "Light or dark, like your operating system", preference: appTheme.PreferenceSystem},
+	}
+	for _, info := range appTheme.Themes() {
+		detail := "Light"
+		if info.Dark {
+			detail = "Dark"
+		}
+		list = append(list, option{title: info.Title, detail: detail, preference: appTheme.Preference(info.Name)})
+	}
+	return list
+}
+
+func query(value string) []picker.Item {
+	matches = matches[:0]
+	var items []picker.Item
+	for _, o := range options() {
+		title := []search.Fragment{{Text: o.title}}
+		if value != "" {
+			var ok bool
+			if _, title, ok = search.Fuzzy(value, o.title); !ok {
+				continue
+			}
+		}
+
+		hint := ""
+		if o.preference == appTheme.CurrentPreference() {
+			hint = "✓"
+		}
+		matches = append(matches, o)
+		items = append(items, picker.Item{Title: title, Detail: o.detail, Hint: hint})
+	}
+	return items
+}
+
+func choose(index int) {
+	appTheme.SetPreference(matches[index].preference)
+}
@@ -0,0 +1,261 @@
+// Package toc is the "On this page" column of the docs pages.
+//
+// The headings come from the generated search index, which also assigns
+// their anchors. gofred cannot set element IDs, so once a page is in the DOM
+// the heading elements are found by the self-link docpage wraps them in, see
+// docpage.HeadingHref, and given their anchor as ID. That makes deep links
+// like /docs/state#hooks-usestate work and lets the column highlight the
+// section being read.
+package toc
+
+import (
+	"strings"
+	"syscall/js"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/link"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/hooks"
+	"github.com/gofred-io/gofred/listenable"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+)
+
+const (
+	// Title heads the column
+	Title = "On this page"
+
+	// spyOffset is how far below the top of the page a heading may be and
+	// still count as the section being read
+	spyOffset = 96
+)
+
+var (
+	active, setActive = hooks.UseState("")
+
+	headings []search.Document
+	elements []js.Value
+
+	// scrolledTo is the path and hash last scrolled to, so rebuilding the
+	// page, like when the theme changes, does not jump back to the heading
+	scrolledTo string
+	bound      bool
+)
+
+// New returns the column for the headings of a page, shown on LG and up
+func New(outline []search.Document) application.BaseWidget {
+	headings = outline
+	elements = nil
+	bind()
+	browser.AfterRender(refresh)
+
+	return container.New(
+		column.New(
+			[]application.BaseWidget{
+				text.New(
+					Title,
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+					text.FontSize(14),
+					text.FontWeight("700"),
+					text.UserSelect(theme.UserSelectTypeNone),
+				),
+				listenable.Builder(active, func() application.BaseWidget {
+					var items []application.BaseWidget
+					for _, heading := range outline {
+						items = append(items, tocItem(heading, heading.Anchor == active.Value()))
+					}
+					return column.New(
+						items,
+						column.Gap(8),
+					)
+				}),
+			},
+			column.Gap(12),
+		),
+		container.Width(breakpoint.All(240)),
+		container.Padding(breakpoint.All(spacing.All(24))),
+		container.BorderWidth(spacing.Left(1)),
+		container.BorderStyle(theme.BorderStyleTypeSolid),
+		container.Visible(
+			breakpoint.LG(true),
+			breakpoint.XL(true),
+			breakpoint.XXL(true),
+		),
+	)
+}
+
+func tocItem(heading search.Document, isActive bool) application.BaseWidget {
+	var label application.BaseWidget
+	if isActive {
+		label = text.New(
+			heading.Section,
+			text.FontColor(appTheme.Colors().Accent),
+			text.FontSize(13),
+			text.FontWeight("500"),
+			text.LineHeight(1.4),
+			text.UserSelect(theme.UserSelectTypeNone),
+		)
+	} else {
+		label = text.New(
+			heading.Section,
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+			text.FontSize(13),
+			text.LineHeight(1.4),
+			text.UserSelect(theme.UserSelectTypeNone),
+		)
+	}
+
+	indent := 0
+	if heading.Level > 2 {
+		indent = 12
+	}
+
+	return link.New(
+		container.New(
+			label,
+			container.Padding(breakpoint.All(spacing.Left(indent))),
+		),
+		link.Href(heading.Href+"#"+heading.Anchor),
+		link.Label(heading.Section),
+	)
+}
+
+// bind tracks scrolling and history changes for the lifetime of the page
+func bind() {
+	if bound {
+		return
+	}
+	bound = true
+
+	scheduled := false
+	browser.Listen(browser.Root(), "scroll", func(js.Value) {
+		if scheduled {
+			return
+		}
+		scheduled = true
+		browser.AfterRender(func() {
+			scheduled = false
+			spy()
+		})
+	})
+
+	// Following a heading link pushes the same page with a new hash
+	browser.Listen(js.Global(), "popstate", func(js.Value) {
+		scrolledTo = ""
+		browser.AfterRender(refresh)
+	})
+}
+
+// refresh anchors the headings of the rendered page, scrolls to the heading
+// in the URL and updates the highlighted item
+func refresh() {
+	if !onPage() {
+		return
+	}
+	attach()
+
+	hash := browser.Hash()
+	location := browser.Path() + "#" + hash
+	if hash != "" && location != scrolledTo {
+		if target := browser.Document().Call("getElementById", hash); target.Truthy() {
+			target.Call("scrollIntoView", map[string]any{"block": "start"})
+		}
+	}
+	scrolledTo = location
+
+	spy()
+}
+
+// onPage reports whether the headings belong to the page being shown
+func onPage() bool {
+	return len(headings) > 0 && headings[0].Href == browser.Path()
+}
+
+// attach gives the heading elements their anchors and pins the column
+func attach() {
+	elements = elements[:0]
+	root := browser.Root()
+
+	// Repeated titles share a link, the nth heading with the title is the
+	// nth element linking to it
+	seen := make(map[string]int)
+	for _, heading := range headings {
+		href := docpage.HeadingHref(heading.Section)
+		nodes := root.Call("querySelectorAll", `a[href="`+href+`"]`)
+		if seen[href] >= nodes.Length() {
+			break
+		}
+		node := nodes.Index(seen[href])
+		seen[href]++
+
+		node.Set("id", heading.Anchor)
+		node.Get("style").Set("scrollMarginTop", "24px")
+		elements = append(elements, node)
+	}
+
+	nodes := root.Call("querySelectorAll", "*")
+	for i := 0; i < nodes.Length(); i++ {
+		node := nodes.Index(i)
+		if node.Get("childElementCount").Int() == 0 && strings.TrimSpace(node.Get("textContent").String()) == Title {
+			pin(node)
+			break
+		}
+	}
+}
+
+// pin keeps the column in view while the page scrolls
+func pin(title js.Value) {
+	// Skip the wrappers gofred puts around the text
+	element := title
+	for element.Get("parentElement").Truthy() && element.Get("parentElement").Get("childElementCount").Int() == 1 {
+		element = element.Get("parentElement")
+	}
+	columnElement := element.Get("parentElement")
+	if !columnElement.Truthy() {
+		return
+	}
+
+	style := columnElement.Get("style")
+	style.Set("position", "sticky")
+	style.Set("top", "24px")
+}
+
+// spy highlights the last heading scrolled past, or the last heading once the
+// bottom of the page is reached
+func spy() {
+	if !onPage() {
+		return
+	}
+	if len(elements) == 0 || !elements[0].Get("isConnected").Bool() {
+		attach()
+		if len(elements) == 0 {
+			return
+		}
+	}
+
+	root := browser.Root()
+	top := root.Call("getBoundingClientRect").Get("top").Float()
+
+	current := headings[0].Anchor
+	for i, element := range elements {
+		if element.Call("getBoundingClientRect").Get("top").Float()-top > spyOffset {
+			break
+		}
+		current = headings[i].Anchor
+	}
+	if root.Get("scrollTop").Float()+root.Get("clientHeight").Float() >= root.Get("scrollHeight").Float()-2 {
+		current = headings[len(elements)-1].Anchor
+	}
+
+	if current != active.Value() {
+		setActive(current)
+	}
+}
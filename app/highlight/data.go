@@ -0,0 +1,230 @@
+package highlight
+
+import (
+	"strings"
+)
+
+var yamlConstants = set("true", "false", "yes", "no", "on", "off", "null", "~", "True", "False", "Null", "TRUE", "FALSE", "NULL")
+
+// yamlTokens scans YAML line by line. Keys are Keys, scalars Strings,
+// Numbers or Builtins, and the lines of | and > blocks are Strings.
+func yamlTokens(b *builder, code string) {
+	// block is the indentation of the key owning a | or > block, -1 outside
+	block := -1
+	for len(code) > 0 {
+		end := lineEnd(code)
+		line := code[:end]
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+
+		switch {
+		case block >= 0 && (strings.TrimSpace(line) == "" || indent > block):
+			b.add(Plain, line[:indent])
+			b.add(String, trimmed)
+		default:
+			block = -1
+			b.add(Plain, line[:indent])
+			if yamlLine(b, trimmed) {
+				block = indent
+			}
+		}
+
+		b.add(Plain, code[end:min(end+1, len(code))])
+		code = code[min(end+1, len(code)):]
+	}
+}
+
+// yamlLine adds the tokens of a line without its indentation and reports
+// whether it starts a block scalar
+func yamlLine(b *builder, line string) bool {
+	if strings.HasPrefix(line, "#") {
+		b.add(Comment, line)
+		return false
+	}
+	if line == "---" || line == "..." {
+		b.add(Keyword, line)
+		return false
+	}
+	for strings.HasPrefix(line, "- ") || line == "-" {
+		b.add(Plain, line[:min(2, len(line))])
+		line = line[min(2, len(line)):]
+	}
+
+	if key := yamlKey(line); key > 0 {
+		b.add(Key, line[:key])
+		b.add(Plain, ":")
+		line = line[key+1:]
+	}
+
+	value, comment := line, ""
+	if i := strings.Index(line, " #"); i >= 0 && !strings.ContainsAny(line[:i], `"'`) {
+		value, comment = line[:i], line[i:]
+	}
+	trimmed := strings.TrimLeft(value, " ")
+	b.add(Plain, value[:len(value)-len(trimmed)])
+	scalar := strings.TrimRight(trimmed, " ")
+	blockScalar := scalar != "" && (scalar[0] == '|' || scalar[0] == '>')
+
+	switch {
+	case scalar == "":
+	case scalar[0] == '"' || scalar[0] == '\'':
+		n := quoted(scalar)
+		b.add(String, scalar[:n])
+		b.add(Plain, scalar[n:])
+	case scalar[0] == '&' || scalar[0] == '*':
+		b.add(Variable, scalar)
+	case blockScalar || scalar[0] == '{' || scalar[0] == '[':
+		b.add(Plain, scalar)
+	case yamlConstants[scalar]:
+		b.add(Builtin, scalar)
+	case isNumber(scalar):
+		b.add(Number, scalar)
+	default:
+		b.add(String, scalar)
+	}
+	b.add(Plain, trimmed[len(scalar):])
+	b.add(Comment, comment)
+	return blockScalar
+}
+
+// yamlKey returns the length of the key at the start of line, a plain or
+// quoted key followed by a colon and a space or the end of the line, or 0
+func yamlKey(line string) int {
+	if line == "" {
+		return 0
+	}
+	end := 0
+	if line[0] == '"' || line[0] == '\'' {
+		end = quoted(line)
+	} else {
+		for end < len(line) && line[end] != ':' && line[end] != '#' {
+			end++
+		}
+	}
+	if end == 0 || end >= len(line) || line[end] != ':' || (end+1 < len(line) && line[end+1] != ' ') {
+		return 0
+	}
+	return end
+}
+
+// jsonTokens scans JSON. Strings followed by a colon are Keys.
+func jsonTokens(b *builder, code string) {
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case c == '"':
+			end := i + quoted(code[i:])
+			kind := String
+			if strings.HasPrefix(strings.TrimLeft(code[end:], " \t\r\n"), ":") {
+				kind = Key
+			}
+			b.add(kind, code[i:end])
+			i = end
+		case c == '-' || isDigit(c):
+			end := i + 1
+			for end < len(code) && (isDigit(code[end]) || strings.IndexByte(".eE+-", code[end]) >= 0) {
+				end++
+			}
+			b.add(Number, code[i:end])
+			i = end
+		case isWordByte(c):
+			end := i
+			for end < len(code) && isWordByte(code[end]) {
+				end++
+			}
+			word := code[i:end]
+			if word == "true" || word == "false" || word == "null" {
+				b.add(Builtin, word)
+			} else {
+				b.add(Plain, word)
+			}
+			i = end
+		default:
+			b.add(Plain, code[i:i+1])
+			i++
+		}
+	}
+}
+
+var dockerfileInstructions = set("FROM", "RUN", "CMD", "LABEL", "MAINTAINER", "EXPOSE", "ENV", "ADD", "COPY",
+	"ENTRYPOINT", "VOLUME", "USER", "WORKDIR", "ARG", "ONBUILD", "STOPSIGNAL", "HEALTHCHECK", "SHELL")
+
+// dockerfileTokens scans a Dockerfile. Instructions are Keywords, RUN, CMD
+// and ENTRYPOINT arguments are shell commands or JSON arrays, and the
+// arguments of other instructions get their strings and variables coloured.
+func dockerfileTokens(b *builder, code string) {
+	for len(code) > 0 {
+		// An instruction runs to the first line not ending in a backslash
+		end := 0
+		for {
+			end += lineEnd(code[end:])
+			if end >= len(code) || !strings.HasSuffix(strings.TrimRight(code[:end], " \t\r"), "\\") {
+				break
+			}
+			end++
+		}
+		dockerfileInstruction(b, code[:end])
+		b.add(Plain, code[end:min(end+1, len(code))])
+		code = code[min(end+1, len(code)):]
+	}
+}
+
+func dockerfileInstruction(b *builder, text string) {
+	trimmed := strings.TrimLeft(text, " \t")
+	b.add(Plain, text[:len(text)-len(trimmed)])
+	if strings.HasPrefix(trimmed, "#") {
+		b.add(Comment, trimmed)
+		return
+	}
+
+	n := 0
+	for n < len(trimmed) && isWordByte(trimmed[n]) {
+		n++
+	}
+	instruction := strings.ToUpper(trimmed[:n])
+	if !dockerfileInstructions[instruction] {
+		b.add(Plain, trimmed)
+		return
+	}
+	b.add(Keyword, trimmed[:n])
+
+	args := trimmed[n:]
+	switch {
+	case (instruction == "RUN" || instruction == "CMD" || instruction == "ENTRYPOINT") && strings.HasPrefix(strings.TrimSpace(args), "["):
+		jsonTokens(b, args)
+	case instruction == "RUN" || instruction == "CMD" || instruction == "ENTRYPOINT":
+		shellTokens(b, args, true)
+	default:
+		var words builder
+		shellTokens(&words, args, false)
+		for _, t := range words.tokens {
+			if instruction == "FROM" && t.Kind == Plain {
+				// FROM image AS name
+				for _, part := range splitWords(t.Text) {
+					if strings.EqualFold(part, "as") {
+						b.add(Keyword, part)
+					} else {
+						b.add(Plain, part)
+					}
+				}
+				continue
+			}
+			b.add(t.Kind, t.Text)
+		}
+	}
+}
+
+// splitWords splits text into words and the spaces between them
+func splitWords(text string) []string {
+	var parts []string
+	for text != "" {
+		n := 1
+		space := text[0] == ' ' || text[0] == '\t'
+		for n < len(text) && (text[n] == ' ' || text[n] == '\t') == space {
+			n++
+		}
+		parts = append(parts, text[:n])
+		text = text[n:]
+	}
+	return parts
+}
@@ -0,0 +1,108 @@
+package highlight
+
+import (
+	"go/scanner"
+	"go/token"
+)
+
+var (
+	goTypes = set("any", "bool", "byte", "comparable", "complex64", "complex128", "error", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64", "rune", "string", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr")
+
+	goBuiltins = set("true", "false", "nil", "iota", "append", "cap", "clear", "close", "complex", "copy", "delete",
+		"imag", "len", "make", "max", "min", "new", "panic", "print", "println", "real", "recover")
+)
+
+// goTokens scans Go code. Samples are often fragments, like a single
+// function, so scan errors are ignored and the rest keeps its colours.
+func goTokens(b *builder, code string) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(code))
+
+	var s scanner.Scanner
+	s.Init(file, []byte(code), func(token.Position, string) {}, scanner.ScanComments)
+
+	var tokens []Token
+	offset := 0
+	previous := token.ILLEGAL
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		// Semicolons inserted at line ends are not in the source
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		start := file.Offset(pos)
+		text := lit
+		if text == "" {
+			text = tok.String()
+		}
+		end := min(start+len(text), len(code))
+		if start < offset {
+			continue
+		}
+
+		tokens = append(tokens, Token{Kind: Plain, Text: code[offset:start]})
+		kind := goKind(tok, lit, previous)
+		if tok == token.LPAREN && len(tokens) > 1 {
+			// A name followed by ( is a call or a declaration
+			if callee := &tokens[len(tokens)-2]; callee.Kind == Plain && isIdent(callee.Text) && tokens[len(tokens)-1].Text == "" {
+				callee.Kind = Function
+			}
+		}
+		tokens = append(tokens, Token{Kind: kind, Text: code[start:end]})
+
+		offset = end
+		previous = tok
+	}
+	tokens = append(tokens, Token{Kind: Plain, Text: code[offset:]})
+
+	for _, t := range tokens {
+		b.add(t.Kind, t.Text)
+	}
+}
+
+func goKind(tok token.Token, lit string, previous token.Token) Kind {
+	switch {
+	case tok.IsKeyword():
+		return Keyword
+	case tok == token.STRING || tok == token.CHAR:
+		return String
+	case tok == token.INT || tok == token.FLOAT || tok == token.IMAG:
+		return Number
+	case tok == token.COMMENT:
+		return Comment
+	case tok != token.IDENT:
+		return Plain
+	case previous == token.FUNC:
+		return Function
+	case previous == token.TYPE || goTypes[lit]:
+		return Type
+	case goBuiltins[lit]:
+		return Builtin
+	}
+	return Plain
+}
+
+func isIdent(text string) bool {
+	if text == "" || isDigit(text[0]) {
+		return false
+	}
+	for i := 0; i < len(text); i++ {
+		if !isWordByte(text[i]) && text[i] < 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+func set(values ...string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
@@ -0,0 +1,206 @@
+// Package highlight splits code samples into tokens for syntax colouring.
+//
+// Go is read with go/scanner, shell, YAML, JSON and Dockerfiles with small
+// scanners that only know what the docs samples need. The tokens of a sample
+// always add up to the sample, so unknown syntax shows as plain text instead
+// of going missing. The package has no gofred imports, the host tools can
+// use it too.
+package highlight
+
+import (
+	"strings"
+)
+
+// Kind is the syntax class of a token
+type Kind int
+
+const (
+	Plain Kind = iota
+	Keyword
+	String
+	Number
+	Comment
+	Type
+	Function
+	Builtin
+	Variable
+	Key
+)
+
+var kindNames = [...]string{"plain", "keyword", "string", "number", "comment", "type", "function", "builtin", "variable", "key"}
+
+// String returns the lower case name of the kind, used in CSS class names
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Kinds returns every kind but Plain
+func Kinds() []Kind {
+	return []Kind{Keyword, String, Number, Comment, Type, Function, Builtin, Variable, Key}
+}
+
+// Token is a piece of a sample
+type Token struct {
+	Kind Kind
+	Text string
+}
+
+// Languages supported by Tokens
+const (
+	Go         = "go"
+	Shell      = "shell"
+	YAML       = "yaml"
+	JSON       = "json"
+	Dockerfile = "dockerfile"
+)
+
+// Language returns the language a Markdown fence info string like "bash" or
+// "yml" names, or "" for languages that are not highlighted
+func Language(name string) string {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "go", "golang":
+		return Go
+	case "sh", "bash", "shell", "console", "zsh":
+		return Shell
+	case "yaml", "yml":
+		return YAML
+	case "json":
+		return JSON
+	case "dockerfile", "docker":
+		return Dockerfile
+	}
+	return ""
+}
+
+// Tokens splits code written in lang, one of the language constants. Other
+// languages come back as a single plain token.
+func Tokens(lang, code string) []Token {
+	var b builder
+	switch lang {
+	case Go:
+		goTokens(&b, code)
+	case Shell:
+		shellTokens(&b, code, true)
+	case YAML:
+		yamlTokens(&b, code)
+	case JSON:
+		jsonTokens(&b, code)
+	case Dockerfile:
+		dockerfileTokens(&b, code)
+	default:
+		b.add(Plain, code)
+	}
+	return b.tokens
+}
+
+// builder collects tokens, merging neighbours of the same kind
+type builder struct {
+	tokens []Token
+}
+
+func (b *builder) add(kind Kind, text string) {
+	if text == "" {
+		return
+	}
+	if n := len(b.tokens); n > 0 && b.tokens[n-1].Kind == kind {
+		b.tokens[n-1].Text += text
+		return
+	}
+	b.tokens = append(b.tokens, Token{Kind: kind, Text: text})
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c)
+}
+
+// isNumber reports whether text is a decimal, hex or float literal
+func isNumber(text string) bool {
+	text = strings.TrimPrefix(text, "-")
+	if text == "" || !isDigit(text[0]) {
+		return false
+	}
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if !isDigit(c) && c != '.' && c != '_' && c != 'x' && c != 'e' && c != 'E' && !(c >= 'a' && c <= 'f') && !(c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// quoted returns the length of the quoted string at the start of s, which
+// starts with the quote. Backslashes escape in double quotes only. Strings
+// missing the closing quote run to the end of the line.
+func quoted(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i + 1
+		case '\n':
+			return i
+		}
+	}
+	return len(s)
+}
+
+// lineEnd returns the index of the newline ending the line s starts in, or
+// len(s)
+func lineEnd(s string) int {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return i
+	}
+	return len(s)
+}
+
+var shellCommands = set("$", "cd", "curl", "docker", "echo", "export", "git", "go", "gofred", "make", "mkdir", "npm", "sudo", "wget")
+
+// Detect guesses the language of a sample written without one, from its
+// first lines. It returns "" when the sample looks like none of them.
+func Detect(code string) string {
+	var lines []string
+	for _, line := range strings.Split(code, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(trimmed, "//") {
+			lines = append(lines, trimmed)
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	first := lines[0]
+	firstWord, _, _ := strings.Cut(first, " ")
+
+	switch {
+	case strings.HasPrefix(first, "package ") || strings.HasPrefix(first, "import ") || strings.HasPrefix(first, "func ") ||
+		strings.HasPrefix(first, "type ") || strings.HasPrefix(first, "var ") || strings.Contains(code, ":= ") ||
+		strings.Contains(code, "func(") || strings.Contains(code, "application."):
+		return Go
+	case strings.EqualFold(firstWord, "FROM") && strings.Contains(code, "\n"):
+		return Dockerfile
+	case first[0] == '{' || first[0] == '[':
+		return JSON
+	case shellCommands[firstWord]:
+		return Shell
+	}
+
+	// YAML starts with a key and is mostly keys and list items, the rest
+	// being the lines of block scalars
+	keys := 0
+	for _, line := range lines {
+		if strings.HasPrefix(line, "- ") || yamlKey(line) > 0 {
+			keys++
+		}
+	}
+	if (yamlKey(first) > 0 || first == "---") && keys*2 > len(lines) {
+		return YAML
+	}
+	return ""
+}
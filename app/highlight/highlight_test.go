@@ -0,0 +1,244 @@
+package highlight
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// samples of every language, with the broken syntax fragments have
+var samples = map[string][]string{
+	Go: {
+		"package main\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n",
+		"x := \"unterminated\ny := 1\n",
+		"s := `raw\nstring that never ends",
+		"/* a comment that never ends\nfunc main() {}",
+		"r := 'x\n// trailing comment",
+		"s := `carriage\r\nreturn`\r\n",
+		"a := b ! @ # ? ~\n",
+		"héllo := \"wörld\" // ünïcode\n",
+		"",
+	},
+	Shell: {
+		"$ gofred app create hello --package github.com/me/hello\n",
+		"echo \"unterminated\nexport NAME='x\ncd $HOME && ls ${GOPATH}/bin # done\n",
+		"if [ -f go.mod ]; then\n  go build ./...\nfi",
+		"VAR=1 go run . \\\n  --flag",
+	},
+	YAML: {
+		"name: app\non:\n  push:\n    branches: [main]\nsteps:\n  - run: go test ./... # tests\n",
+		"key: \"unterminated\nother: 'also\n",
+		"script: |\n  echo hi\n  echo there\n---\n- 1\n- true\n",
+	},
+	JSON: {
+		"{\n  \"name\": \"app\",\n  \"port\": 8080,\n  \"debug\": false,\n  \"tags\": [null, 1.5e3]\n}\n",
+		"{\"key\": \"unterminated\n}",
+		"[1, 2, {\"a\":",
+	},
+	Dockerfile: {
+		"FROM golang:1.22 AS build\nWORKDIR /src\nCOPY . .\nRUN go build -o /app # build\nCMD [\"/app\"]\n",
+		"# syntax\nENV NAME=\"unterminated\nRUN echo \\\n  $NAME\n",
+	},
+	"text": {"anything at all\n"},
+}
+
+// TestRoundTrip checks the tokens of every sample add up to the sample
+func TestRoundTrip(t *testing.T) {
+	for lang, codes := range samples {
+		for _, code := range codes {
+			tokens := Tokens(lang, code)
+			var b strings.Builder
+			for i, token := range tokens {
+				if token.Text == "" {
+					t.Errorf("%s %q: empty token %d", lang, code, i)
+				}
+				if i > 0 && tokens[i-1].Kind == token.Kind {
+					t.Errorf("%s %q: tokens %d and %d are both %s", lang, code, i-1, i, token.Kind)
+				}
+				b.WriteString(token.Text)
+			}
+			if got := b.String(); got != code {
+				t.Errorf("%s: tokens add up to\n%q\nwant\n%q", lang, got, code)
+			}
+		}
+	}
+}
+
+func TestGoKinds(t *testing.T) {
+	code := `package main
+
+import "fmt"
+
+type Counter struct{ n int }
+
+// Add counts
+func (c *Counter) Add(delta int) error {
+	items := make([]string, 0)
+	fmt.Println(len(items), 0x1F, 2.5, 'x', nil)
+	var err error
+	return err
+}
+`
+	want := map[string]Kind{
+		"package":       Keyword,
+		"main":          Plain,
+		`"fmt"`:         String,
+		"Counter":       Type,
+		"int":           Type,
+		"// Add counts": Comment,
+		"Add":           Function,
+		"error":         Type,
+		"make":          Builtin,
+		"string":        Type,
+		"0":             Number,
+		"Println":       Function,
+		"len":           Builtin,
+		"0x1F":          Number,
+		"2.5":           Number,
+		"'x'":           String,
+		"nil":           Builtin,
+		"items":         Plain,
+		"return":        Keyword,
+	}
+	got := kinds(Tokens(Go, code))
+	for text, kind := range want {
+		if got[text] != kind {
+			t.Errorf("%q is %s, want %s", text, got[text], kind)
+		}
+	}
+}
+
+func TestShellKinds(t *testing.T) {
+	got := kinds(Tokens(Shell, "$ export NAME=\"app\"\ngo build -o $HOME/bin # build it\n"))
+	for text, kind := range map[string]Kind{
+		`"app"`:      String,
+		"$HOME":      Variable,
+		"# build it": Comment,
+	} {
+		if got[text] != kind {
+			t.Errorf("%q is %s, want %s", text, got[text], kind)
+		}
+	}
+}
+
+func TestShellIn(t *testing.T) {
+	tests := []struct {
+		code string
+		want []Kind
+	}{
+		{"for f in *.go; do echo $f; done\n", []Kind{Keyword}},
+		{"case \"$1\" in\n  build) go build ;;\nesac\n", []Kind{Keyword}},
+		{"select name in a b; do break; done\n", []Kind{Keyword}},
+		{"echo logged in\n", []Kind{Plain}},
+		{"git log --author in\nls in\n", []Kind{Plain, Plain}},
+		{"for x in a; do grep in $x; done\n", []Kind{Keyword, Plain}},
+	}
+	for _, tt := range tests {
+		var got []Kind
+		for _, token := range Tokens(Shell, tt.code) {
+			// Plain words come merged with the space around them
+			for _, word := range strings.Fields(token.Text) {
+				if word == "in" {
+					got = append(got, token.Kind)
+				}
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("in of %q is %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestDataKinds(t *testing.T) {
+	tests := []struct {
+		lang string
+		code string
+		want map[string]Kind
+	}{
+		{YAML, "name: app # the name\nport: 8080\ndebug: true\n", map[string]Kind{
+			"name":       Key,
+			"app":        String,
+			"# the name": Comment,
+			"8080":       Number,
+			"true":       Builtin,
+		}},
+		{JSON, `{"name": "app", "port": 8080, "debug": false}`, map[string]Kind{
+			`"name"`: Key,
+			`"app"`:  String,
+			"8080":   Number,
+			"false":  Builtin,
+		}},
+		{Dockerfile, "FROM golang:1.22\nRUN go build # build\n", map[string]Kind{
+			"FROM":    Keyword,
+			"RUN":     Keyword,
+			"# build": Comment,
+		}},
+	}
+	for _, tt := range tests {
+		got := kinds(Tokens(tt.lang, tt.code))
+		for text, kind := range tt.want {
+			if got[text] != kind {
+				t.Errorf("%s: %q is %s, want %s", tt.lang, text, got[text], kind)
+			}
+		}
+	}
+}
+
+// kinds maps the trimmed text of the tokens to their kinds
+func kinds(tokens []Token) map[string]Kind {
+	m := make(map[string]Kind)
+	for _, token := range tokens {
+		if text := strings.TrimSpace(token.Text); text != "" {
+			m[text] = token.Kind
+		}
+	}
+	return m
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"package main\n\nfunc main() {}\n", Go},
+		{"// Create a button\nbutton.New(text.New(\"Hi\"))\nx := 1\n", Go},
+		{"app := application.New()\n", Go},
+		{"FROM golang:1.22\nRUN go build\n", Dockerfile},
+		{`{"name": "app"}`, JSON},
+		{"[1, 2]", JSON},
+		{"$ gofred app create hello\n", Shell},
+		{"# Install\ngo install github.com/gofred-io/gofred-cli@latest\n", Shell},
+		{"name: app\nsteps:\n  - run: go test\n", YAML},
+		{"---\nname: app\nport: 8080\n", YAML},
+		{"my-app/\n├── main.go\n└── go.mod\n", ""},
+		{"Just some text: with a colon\nand more prose\nand more\n", ""},
+		{"", ""},
+		{"# only a comment\n", ""},
+	}
+	for _, tt := range tests {
+		if got := Detect(tt.code); got != tt.want {
+			t.Errorf("Detect(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	for name, want := range map[string]string{
+		"go": Go, "Golang": Go, "bash": Shell, " sh ": Shell, "console": Shell,
+		"yml": YAML, "json": JSON, "Dockerfile": Dockerfile, "text": "", "": "",
+	} {
+		if got := Language(name); got != want {
+			t.Errorf("Language(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestKinds(t *testing.T) {
+	got := Kinds()
+	if len(got) != len(kindNames)-1 {
+		t.Errorf("Kinds() = %v, missing some", got)
+	}
+	if Plain.String() != "plain" || Key.String() != "key" {
+		t.Errorf("kind names = %q, %q", Plain, Key)
+	}
+}
@@ -0,0 +1,129 @@
+package highlight
+
+import (
+	"strings"
+)
+
+var shellKeywords = set("if", "then", "else", "elif", "fi", "for", "while", "until", "do", "done", "case", "esac",
+	"function", "select", "time", "export", "local", "return", "sudo")
+
+// shellTokens scans shell commands. The first word of every command is a
+// Function, variables like $HOME are Variables and assignments before a
+// command are Variables up to the =. command says whether code starts with
+// a command, Dockerfile arguments that are not RUN lines don't. "in" is a
+// Keyword only in the for, case and select it belongs to.
+func shellTokens(b *builder, code string, command bool) {
+	commandStart := command
+	wordStart := true
+	// expectIn is set from for, case and select up to their in
+	expectIn := false
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case c == '\n':
+			b.add(Plain, "\n")
+			i++
+			commandStart, wordStart, expectIn = command, true, false
+		case c == ' ' || c == '\t':
+			b.add(Plain, code[i:i+1])
+			i++
+			wordStart = true
+		case c == '\\' && i+1 < len(code):
+			// Escapes and line continuations keep the command going
+			b.add(Plain, code[i:i+2])
+			i += 2
+			wordStart = false
+		case c == '#' && wordStart:
+			end := i + lineEnd(code[i:])
+			b.add(Comment, code[i:end])
+			i = end
+		case c == '\'' || c == '"':
+			end := i + quoted(code[i:])
+			b.add(String, code[i:end])
+			i = end
+			commandStart, wordStart = false, false
+		case c == '$' && i+1 < len(code) && code[i+1] == '(':
+			b.add(Plain, "$(")
+			i += 2
+			commandStart, wordStart = true, true
+		case c == '$':
+			end := i + variable(code[i:])
+			b.add(Variable, code[i:end])
+			i = end
+			commandStart, wordStart = false, false
+		case strings.IndexByte("|;&()<>`", c) >= 0:
+			b.add(Plain, code[i:i+1])
+			i++
+			wordStart = true
+			if c != '>' && c != '<' && c != ')' {
+				commandStart, expectIn = true, false
+			}
+		default:
+			end := i
+			for end < len(code) && strings.IndexByte(" \t\n|;&()<>`'\"$\\", code[end]) < 0 {
+				end++
+			}
+			if end == i {
+				// A backslash ending the sample
+				end++
+			}
+			word := code[i:end]
+			switch {
+			case commandStart && shellKeywords[word]:
+				b.add(Keyword, word)
+				// Names follow these, not commands
+				if word == "for" || word == "case" || word == "select" || word == "function" {
+					commandStart = false
+				}
+				expectIn = word == "for" || word == "case" || word == "select"
+			case expectIn && word == "in":
+				b.add(Keyword, word)
+				expectIn = false
+			case commandStart && assignment(word) > 0:
+				n := assignment(word)
+				b.add(Variable, word[:n])
+				b.add(Plain, word[n:])
+			case commandStart:
+				b.add(Function, word)
+				commandStart = false
+			case isNumber(word):
+				b.add(Number, word)
+			default:
+				b.add(Plain, word)
+			}
+			i = end
+			wordStart = false
+		}
+	}
+}
+
+// variable returns the length of the variable reference at the start of s,
+// like $HOME, ${GOPATH} or $1
+func variable(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+	if s[1] == '{' {
+		if end := strings.IndexByte(s, '}'); end > 0 {
+			return end + 1
+		}
+		return len(s)
+	}
+	if strings.IndexByte("?#@*!$-0123456789", s[1]) >= 0 {
+		return 2
+	}
+	n := 1
+	for n < len(s) && isWordByte(s[n]) {
+		n++
+	}
+	return n
+}
+
+// assignment returns the length of NAME= at the start of word, or 0
+func assignment(word string) int {
+	eq := strings.IndexByte(word, '=')
+	if eq <= 0 || !isIdent(word[:eq]) {
+		return 0
+	}
+	return eq + 1
+}
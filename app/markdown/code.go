@@ -0,0 +1,122 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CodeOptions are the options a fenced code block's info string sets after
+// the language, in the form
+//
+//	```go title="main.go" showLineNumbers {12-18,20} diff check
+type CodeOptions struct {
+	Title       string
+	LineNumbers bool
+
+	// Tab is the label of the block in a group of tabs, which consecutive
+	// blocks with a tab="..." option form
+	Tab string
+
+	// Lines are the highlighted lines
+	Lines []LineRange
+
+	// Diff marks the code as a diff, its lines start with +, - or a space
+	Diff bool
+
+	// Check marks a Go fragment cmd/samplecheck compiles. Complete programs
+	// are compiled without it.
+	Check bool
+
+	// Unknown holds the options that were not understood
+	Unknown []string
+}
+
+// LineRange is a range of lines, numbered from 1, To included
+type LineRange struct {
+	From int
+	To   int
+}
+
+// ParseCodeOptions parses the Info of a code block
+func ParseCodeOptions(info string) CodeOptions {
+	var options CodeOptions
+	for _, word := range infoWords(info) {
+		switch {
+		case strings.HasPrefix(word, "title="):
+			options.Title = optionValue(word)
+		case strings.HasPrefix(word, "tab="):
+			options.Tab = optionValue(word)
+		case word == "showLineNumbers":
+			options.LineNumbers = true
+		case word == "diff":
+			options.Diff = true
+		case word == "check":
+			options.Check = true
+		case strings.HasPrefix(word, "{") && strings.HasSuffix(word, "}"):
+			lines, ok := lineRanges(word[1 : len(word)-1])
+			if !ok {
+				options.Unknown = append(options.Unknown, word)
+				continue
+			}
+			options.Lines = append(options.Lines, lines...)
+		default:
+			options.Unknown = append(options.Unknown, word)
+		}
+	}
+	return options
+}
+
+// optionValue returns the value of a key=value option, unquoted
+func optionValue(word string) string {
+	_, value, _ := strings.Cut(word, "=")
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+	return value
+}
+
+// infoWords splits info at spaces outside double quotes
+func infoWords(info string) []string {
+	var words []string
+	var word strings.Builder
+	quoted := false
+	for _, r := range info {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			word.WriteRune(r)
+		case (r == ' ' || r == '\t') && !quoted:
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+		default:
+			word.WriteRune(r)
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// lineRanges parses a list like "12-18,20"
+func lineRanges(list string) ([]LineRange, bool) {
+	var ranges []LineRange
+	for _, part := range strings.Split(list, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		if !isRange {
+			to = from
+		}
+		first, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, false
+		}
+		last, err := strconv.Atoi(to)
+		if err != nil || first < 1 || last < first {
+			return nil, false
+		}
+		ranges = append(ranges, LineRange{From: first, To: last})
+	}
+	return ranges, true
+}
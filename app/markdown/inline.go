@@ -0,0 +1,188 @@
+package markdown
+
+import (
+	"strings"
+)
+
+// parseInlines splits a line of markdown into text, code, link and emphasis runs
+func parseInlines(source string) []Inline {
+	var inlines []Inline
+	var text strings.Builder
+
+	flushText := func() {
+		if text.Len() > 0 {
+			inlines = append(inlines, Inline{Kind: InlineText, Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(source); {
+		switch c := source[i]; {
+		case c == '\\' && i+1 < len(source) && isEscapable(source[i+1]):
+			text.WriteByte(source[i+1])
+			i += 2
+
+		case c == '`':
+			ticks := countRun(source[i:], '`')
+			end := strings.Index(source[i+ticks:], strings.Repeat("`", ticks))
+			if end < 0 {
+				text.WriteString(source[i : i+ticks])
+				i += ticks
+				continue
+			}
+
+			flushText()
+			code := source[i+ticks : i+ticks+end]
+			if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' {
+				code = code[1 : len(code)-1]
+			}
+			inlines = append(inlines, Inline{Kind: InlineCode, Text: code})
+			i += ticks + end + ticks
+
+		case c == '[':
+			label, href, length, ok := parseLink(source[i:])
+			if !ok {
+				text.WriteByte(c)
+				i++
+				continue
+			}
+
+			flushText()
+			inlines = append(inlines, Inline{Kind: InlineLink, Text: label, Href: href})
+			i += length
+
+		case c == '*' || c == '_':
+			run := countRun(source[i:], c)
+			if run > 2 {
+				run = 2
+			}
+
+			delimiter := strings.Repeat(string(c), run)
+			end := -1
+			if canOpen(source, i, run) {
+				end = closer(source, i+run, delimiter)
+			}
+			if end <= 0 {
+				text.WriteString(delimiter)
+				i += run
+				continue
+			}
+
+			flushText()
+			kind := InlineEmphasis
+			if run == 2 {
+				kind = InlineStrong
+			}
+			inlines = append(inlines, Inline{Kind: kind, Text: source[i+run : i+run+end]})
+			i += run + end + run
+
+		default:
+			text.WriteByte(c)
+			i++
+		}
+	}
+
+	flushText()
+	return inlines
+}
+
+// closer returns the offset from start of the first run of delimiter after
+// it that can close emphasis, or -1
+func closer(source string, start int, delimiter string) int {
+	for offset := 0; ; offset++ {
+		next := strings.Index(source[start+offset:], delimiter)
+		if next < 0 {
+			return -1
+		}
+		offset += next
+		if canClose(source, start+offset, len(delimiter)) {
+			return offset
+		}
+	}
+}
+
+// canOpen reports whether the run of n delimiters at i may open emphasis.
+// Following CommonMark, the run has to be left-flanking, and an underscore
+// run must not sit inside a word, so my_var_name stays plain text.
+func canOpen(source string, i, n int) bool {
+	left, right := flanking(source, i, n)
+	if source[i] == '_' {
+		return left && (!right || isPunctuation(before(source, i)))
+	}
+	return left
+}
+
+// canClose is canOpen for the run closing emphasis
+func canClose(source string, i, n int) bool {
+	left, right := flanking(source, i, n)
+	if source[i] == '_' {
+		return right && (!left || isPunctuation(after(source, i+n)))
+	}
+	return right
+}
+
+// flanking reports whether the delimiter run source[i:i+n] is left-flanking,
+// meaning it can start emphasis, and right-flanking, meaning it can end it
+func flanking(source string, i, n int) (left, right bool) {
+	prev, next := before(source, i), after(source, i+n)
+	left = !isSpace(next) && (!isPunctuation(next) || isSpace(prev) || isPunctuation(prev))
+	right = !isSpace(prev) && (!isPunctuation(prev) || isSpace(next) || isPunctuation(next))
+	return left, right
+}
+
+// before returns the byte before i, a space at the start of the line
+func before(source string, i int) byte {
+	if i == 0 {
+		return ' '
+	}
+	return source[i-1]
+}
+
+// after returns the byte at i, a space past the end of the line
+func after(source string, i int) byte {
+	if i >= len(source) {
+		return ' '
+	}
+	return source[i]
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isPunctuation(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// parseLink parses a [label](href) link at the start of source
+func parseLink(source string) (label, href string, length int, ok bool) {
+	closeLabel := strings.Index(source, "](")
+	if closeLabel < 0 {
+		return "", "", 0, false
+	}
+
+	closeHref := strings.IndexByte(source[closeLabel+2:], ')')
+	if closeHref < 0 {
+		return "", "", 0, false
+	}
+
+	label = source[1:closeLabel]
+	href = strings.TrimSpace(source[closeLabel+2 : closeLabel+2+closeHref])
+	if strings.ContainsAny(label, "[]") || href == "" {
+		return "", "", 0, false
+	}
+
+	return label, href, closeLabel + 2 + closeHref + 1, true
+}
+
+func countRun(source string, c byte) int {
+	count := 0
+	for count < len(source) && source[count] == c {
+		count++
+	}
+	return count
+}
+
+func isEscapable(c byte) bool {
+	return strings.IndexByte("\\`*_{}[]()#+-.!|", c) >= 0
+}
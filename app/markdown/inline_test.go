@@ -0,0 +1,67 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+func text(s string) Inline     { return Inline{Kind: InlineText, Text: s} }
+func code(s string) Inline     { return Inline{Kind: InlineCode, Text: s} }
+func emphasis(s string) Inline { return Inline{Kind: InlineEmphasis, Text: s} }
+func strong(s string) Inline   { return Inline{Kind: InlineStrong, Text: s} }
+
+func TestParseInlines(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Inline
+	}{
+		{"plain", "just text", []Inline{text("just text")}},
+		{"empty", "", nil},
+
+		// Emphasis
+		{"star emphasis", "an *important* word", []Inline{text("an "), emphasis("important"), text(" word")}},
+		{"underscore emphasis", "an _important_ word", []Inline{text("an "), emphasis("important"), text(" word")}},
+		{"star strong", "**bold** start", []Inline{strong("bold"), text(" start")}},
+		{"underscore strong", "end __bold__", []Inline{text("end "), strong("bold")}},
+		{"star inside word", "un*frigging*believable", []Inline{text("un"), emphasis("frigging"), text("believable")}},
+		{"underscores inside words", "use my_var and other_thing", []Inline{text("use my_var and other_thing")}},
+		{"snake case", "call set_up_state_hook", []Inline{text("call set_up_state_hook")}},
+		{"underscore closer inside word", "_foo_bar", []Inline{text("_foo_bar")}},
+		{"underscore after punctuation", "(_note_)", []Inline{text("("), emphasis("note"), text(")")}},
+		{"opener before space", "a * b * c", []Inline{text("a * b * c")}},
+		{"closer after space", "*not emphasis *", []Inline{text("*not emphasis *")}},
+		{"unclosed", "a *lonely star", []Inline{text("a *lonely star")}},
+		{"empty emphasis", "****", []Inline{text("****")}},
+
+		// Code spans
+		{"code", "run `go build` now", []Inline{text("run "), code("go build"), text(" now")}},
+		{"code with backtick", "``a ` b``", []Inline{code("a ` b")}},
+		{"code strips one space", "`` `tick` ``", []Inline{code("`tick`")}},
+		{"code keeps emphasis", "`*p_x*`", []Inline{code("*p_x*")}},
+		{"unclosed code", "a `b", []Inline{text("a `b")}},
+
+		// Links
+		{"link", "see [the docs](/docs) first", []Inline{
+			text("see "), {Kind: InlineLink, Text: "the docs", Href: "/docs"}, text(" first"),
+		}},
+		{"link trims href", "[x]( https://gofred.io )", []Inline{{Kind: InlineLink, Text: "x", Href: "https://gofred.io"}}},
+		{"link without href", "[x]() y", []Inline{text("[x]() y")}},
+		{"bracket without link", "a [b] c", []Inline{text("a [b] c")}},
+
+		// Escapes
+		{"escaped star", `\*not emphasis\*`, []Inline{text("*not emphasis*")}},
+		{"escaped underscore", `\_x\_`, []Inline{text("_x_")}},
+		{"escaped backtick", "\\`x\\`", []Inline{text("`x`")}},
+		{"escaped bracket", `\[x](y)`, []Inline{text("[x](y)")}},
+		{"backslash kept", `a\b`, []Inline{text(`a\b`)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseInlines(tt.source); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseInlines(%q)\n got %#v\nwant %#v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package layout
+
+import (
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+)
+
+// Kind identifies the widget a page element is rendered with
+type Kind int
+
+const (
+	Section Kind = iota
+	Subsection
+	Paragraph
+	CheckList
+	OrderedList
+	TermList
+	NextSteps
+	Code
+
+	// CodeGroup is consecutive code blocks with a tab option, shown as tabs
+	CodeGroup
+)
+
+// Page is a markdown document arranged into the elements of a docs page
+type Page struct {
+	Title       string
+	Description []markdown.Inline
+	Elements    []Element
+}
+
+// Element is a single widget of the page body. Only the fields relevant to its kind are set.
+type Element struct {
+	Kind Kind
+	Line int
+
+	// Section and subsection
+	Title       string
+	Description []markdown.Inline
+
+	// Paragraph
+	Inlines []markdown.Inline
+
+	// Lists
+	Start int
+	Items []Item
+
+	// Code
+	Lang    string
+	Options markdown.CodeOptions
+	Code    string
+
+	// CodeGroup, Code elements labelled by their Options.Tab
+	Tabs []Element
+}
+
+// Item is a single entry of a list element
+type Item struct {
+	Line int
+
+	// Inlines is set for check and ordered lists
+	Inlines []markdown.Inline
+
+	// Title, Description and Href are set for term lists and next steps
+	Title       string
+	Description string
+	Href        string
+}
+
+// Link is a link found on a page together with the line it is on
+type Link struct {
+	Line int
+	Href string
+}
+
+// Build arranges a markdown document into a docs page. The first level one
+// heading and the paragraph after it become the page header, and a paragraph
+// right after a heading becomes that heading's description.
+func Build(doc markdown.Document) Page {
+	var page Page
+
+	blocks := doc.Blocks
+	if len(blocks) > 0 && blocks[0].Kind == markdown.BlockHeading && blocks[0].Level == 1 {
+		page.Title = markdown.Text(blocks[0].Inlines)
+		blocks = blocks[1:]
+		if len(blocks) > 0 && blocks[0].Kind == markdown.BlockParagraph {
+			page.Description = blocks[0].Inlines
+			blocks = blocks[1:]
+		}
+	}
+
+	for i := 0; i < len(blocks); i++ {
+		block := blocks[i]
+
+		switch block.Kind {
+		case markdown.BlockHeading:
+			element := Element{
+				Kind:  Section,
+				Line:  block.Line,
+				Title: markdown.Text(block.Inlines),
+			}
+			if block.Level > 2 {
+				element.Kind = Subsection
+			}
+			if i+1 < len(blocks) && blocks[i+1].Kind == markdown.BlockParagraph {
+				element.Description = blocks[i+1].Inlines
+				i++
+			}
+			page.Elements = append(page.Elements, element)
+		case markdown.BlockParagraph:
+			page.Elements = append(page.Elements, Element{
+				Kind:    Paragraph,
+				Line:    block.Line,
+				Inlines: block.Inlines,
+			})
+		case markdown.BlockList:
+			page.Elements = append(page.Elements, list(block))
+		case markdown.BlockCode:
+			element := Element{
+				Kind:    Code,
+				Line:    block.Line,
+				Lang:    block.Lang,
+				Options: markdown.ParseCodeOptions(block.Info),
+				Code:    block.Code,
+			}
+			if element.Options.Tab == "" {
+				page.Elements = append(page.Elements, element)
+				continue
+			}
+			if n := len(page.Elements); n > 0 && page.Elements[n-1].Kind == CodeGroup && i > 0 && blocks[i-1].Kind == markdown.BlockCode {
+				page.Elements[n-1].Tabs = append(page.Elements[n-1].Tabs, element)
+				continue
+			}
+			page.Elements = append(page.Elements, Element{Kind: CodeGroup, Line: block.Line, Tabs: []Element{element}})
+		}
+	}
+
+	return page
+}
+
+// Links returns every link on the page, including the navigation buttons
+func (p Page) Links() []Link {
+	var links []Link
+
+	addInlines := func(line int, inlines []markdown.Inline) {
+		for _, inline := range inlines {
+			if inline.Kind == markdown.InlineLink {
+				links = append(links, Link{Line: line, Href: inline.Href})
+			}
+		}
+	}
+
+	addInlines(0, p.Description)
+	for _, element := range p.Elements {
+		addInlines(element.Line, element.Description)
+		addInlines(element.Line, element.Inlines)
+		for _, item := range element.Items {
+			addInlines(item.Line, item.Inlines)
+			if item.Href != "" {
+				links = append(links, Link{Line: item.Line, Href: item.Href})
+			}
+		}
+	}
+
+	return links
+}
+
+// list picks the element for a list from the shape of its items: link lists become
+// next step cards, "**term** - description" lists become term cards and
+// everything else becomes a checked or numbered list.
+func list(block markdown.Block) Element {
+	element := Element{
+		Kind:  CheckList,
+		Line:  block.Line,
+		Start: block.Start,
+	}
+
+	switch {
+	case allItemsStartWith(block.Items, markdown.InlineLink):
+		element.Kind = NextSteps
+		for _, item := range block.Items {
+			element.Items = append(element.Items, Item{
+				Line:        item.Line,
+				Title:       item.Inlines[0].Text,
+				Href:        item.Inlines[0].Href,
+				Description: itemDescription(item),
+			})
+		}
+	case allItemsStartWith(block.Items, markdown.InlineStrong):
+		element.Kind = TermList
+		for _, item := range block.Items {
+			element.Items = append(element.Items, Item{
+				Line:        item.Line,
+				Title:       item.Inlines[0].Text,
+				Description: itemDescription(item),
+			})
+		}
+	default:
+		if block.Ordered {
+			element.Kind = OrderedList
+		}
+		for _, item := range block.Items {
+			element.Items = append(element.Items, Item{
+				Line:    item.Line,
+				Inlines: item.Inlines,
+			})
+		}
+	}
+
+	return element
+}
+
+func allItemsStartWith(items []markdown.ListItem, kind markdown.InlineKind) bool {
+	for _, item := range items {
+		if len(item.Inlines) == 0 || item.Inlines[0].Kind != kind {
+			return false
+		}
+	}
+	return len(items) > 0
+}
+
+// itemDescription returns the text following the leading link or term of a list item
+func itemDescription(item markdown.ListItem) string {
+	description := markdown.Text(item.Inlines[1:])
+	return strings.TrimLeft(description, " -–—:")
+}
@@ -0,0 +1,272 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BlockKind identifies the type of a block level element
+type BlockKind int
+
+const (
+	BlockHeading BlockKind = iota
+	BlockParagraph
+	BlockList
+	BlockCode
+)
+
+// InlineKind identifies the type of an inline element
+type InlineKind int
+
+const (
+	InlineText InlineKind = iota
+	InlineCode
+	InlineLink
+	InlineStrong
+	InlineEmphasis
+)
+
+// Document is a parsed markdown file
+type Document struct {
+	// Meta holds the key/value pairs of the optional front matter block
+	Meta   map[string]string
+	Blocks []Block
+}
+
+// Block is a block level element. Only the fields relevant to its kind are set.
+type Block struct {
+	Kind BlockKind
+	Line int
+
+	// Heading
+	Level int
+
+	// Heading and paragraph
+	Inlines []Inline
+
+	// List
+	Ordered bool
+	Start   int
+	Items   []ListItem
+
+	// Fenced code block. Info is the rest of the info string after the
+	// language, see ParseCodeOptions.
+	Lang string
+	Info string
+	Code string
+}
+
+// ListItem is a single entry of a list block
+type ListItem struct {
+	Line    int
+	Inlines []Inline
+}
+
+// Inline is a run of text inside a heading, paragraph or list item
+type Inline struct {
+	Kind InlineKind
+	Text string
+	Href string
+}
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	bulletItemPattern  = regexp.MustCompile(`^\s{0,3}[-*+]\s+(.*)$`)
+	orderedItemPattern = regexp.MustCompile(`^\s{0,3}(\d{1,9})[.)]\s+(.*)$`)
+	fencePattern       = regexp.MustCompile("^\\s{0,3}(`{3,}|~{3,})\\s*([^`\\s]*)([^`]*)")
+)
+
+// Parse parses markdown source into a document
+func Parse(source []byte) Document {
+	p := &parser{
+		lines: strings.Split(strings.ReplaceAll(string(source), "\r\n", "\n"), "\n"),
+		doc:   Document{Meta: map[string]string{}},
+	}
+	p.parse()
+	return p.doc
+}
+
+// Text returns the plain text of a run of inlines
+func Text(inlines []Inline) string {
+	var builder strings.Builder
+	for _, inline := range inlines {
+		builder.WriteString(inline.Text)
+	}
+	return builder.String()
+}
+
+// Title returns the text of the first level one heading
+func (d Document) Title() string {
+	for _, block := range d.Blocks {
+		if block.Kind == BlockHeading && block.Level == 1 {
+			return Text(block.Inlines)
+		}
+	}
+	return ""
+}
+
+type parser struct {
+	lines []string
+	pos   int
+	doc   Document
+
+	paragraph     []string
+	paragraphLine int
+	list          *Block
+	itemLines     []string
+}
+
+func (p *parser) parse() {
+	p.parseFrontMatter()
+
+	for ; p.pos < len(p.lines); p.pos++ {
+		line := p.lines[p.pos]
+		lineNumber := p.pos + 1
+
+		if strings.TrimSpace(line) == "" {
+			p.flush()
+			continue
+		}
+
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			p.flush()
+			p.parseFence(match[1], match[2], strings.TrimSpace(match[3]))
+			continue
+		}
+
+		if match := headingPattern.FindStringSubmatch(line); match != nil {
+			p.flush()
+			p.doc.Blocks = append(p.doc.Blocks, Block{
+				Kind:    BlockHeading,
+				Line:    lineNumber,
+				Level:   len(match[1]),
+				Inlines: parseInlines(match[2]),
+			})
+			continue
+		}
+
+		if match := bulletItemPattern.FindStringSubmatch(line); match != nil {
+			p.startItem(false, 0, match[1], lineNumber)
+			continue
+		}
+
+		if match := orderedItemPattern.FindStringSubmatch(line); match != nil {
+			start, _ := strconv.Atoi(match[1])
+			p.startItem(true, start, match[2], lineNumber)
+			continue
+		}
+
+		if p.list != nil {
+			// Continuation of the current list item
+			p.itemLines = append(p.itemLines, strings.TrimSpace(line))
+			continue
+		}
+
+		if p.paragraph == nil {
+			p.paragraphLine = lineNumber
+		}
+		p.paragraph = append(p.paragraph, strings.TrimSpace(line))
+	}
+
+	p.flush()
+}
+
+func (p *parser) parseFrontMatter() {
+	if len(p.lines) == 0 || strings.TrimSpace(p.lines[0]) != "---" {
+		return
+	}
+
+	for i := 1; i < len(p.lines); i++ {
+		line := strings.TrimSpace(p.lines[i])
+		if line == "---" {
+			p.pos = i + 1
+			return
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		p.doc.Meta[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+
+	// No closing delimiter, treat the document as having no front matter
+	p.doc.Meta = map[string]string{}
+}
+
+func (p *parser) parseFence(marker, lang, info string) {
+	startLine := p.pos + 1
+	indent := len(p.lines[p.pos]) - len(strings.TrimLeft(p.lines[p.pos], " "))
+
+	var code []string
+	for p.pos++; p.pos < len(p.lines); p.pos++ {
+		line := p.lines[p.pos]
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, marker[:1]) && strings.Trim(trimmed, marker[:1]) == "" && len(trimmed) >= len(marker) {
+			break
+		}
+		code = append(code, removeIndent(line, indent))
+	}
+
+	p.doc.Blocks = append(p.doc.Blocks, Block{
+		Kind: BlockCode,
+		Line: startLine,
+		Lang: lang,
+		Info: info,
+		Code: strings.Join(code, "\n"),
+	})
+}
+
+func (p *parser) startItem(ordered bool, start int, content string, lineNumber int) {
+	if p.paragraph != nil || (p.list != nil && p.list.Ordered != ordered) {
+		p.flush()
+	}
+
+	p.flushItem()
+	if p.list == nil {
+		p.list = &Block{
+			Kind:    BlockList,
+			Line:    lineNumber,
+			Ordered: ordered,
+			Start:   start,
+		}
+	}
+
+	p.list.Items = append(p.list.Items, ListItem{Line: lineNumber})
+	p.itemLines = []string{content}
+}
+
+func (p *parser) flushItem() {
+	if p.list == nil || len(p.list.Items) == 0 || p.itemLines == nil {
+		return
+	}
+
+	item := &p.list.Items[len(p.list.Items)-1]
+	item.Inlines = parseInlines(strings.Join(p.itemLines, " "))
+	p.itemLines = nil
+}
+
+func (p *parser) flush() {
+	if p.paragraph != nil {
+		p.doc.Blocks = append(p.doc.Blocks, Block{
+			Kind:    BlockParagraph,
+			Line:    p.paragraphLine,
+			Inlines: parseInlines(strings.Join(p.paragraph, " ")),
+		})
+		p.paragraph = nil
+	}
+
+	if p.list != nil {
+		p.flushItem()
+		p.doc.Blocks = append(p.doc.Blocks, *p.list)
+		p.list = nil
+	}
+}
+
+func removeIndent(line string, indent int) string {
+	for i := 0; i < indent && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+	return line
+}
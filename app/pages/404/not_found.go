@@ -60,11 +60,11 @@ func errorIcon() application.BaseWidget {
 			icondata.Alert,
 			icon.Width(breakpoint.All(120)),
 			icon.Height(breakpoint.All(120)),
-			icon.Fill("#E74C3C"),
+			icon.Fill(appTheme.Colors().Error),
 		),
 		container.BorderRadius(48),
 		container.Padding(breakpoint.All(spacing.All(20))),
-		container.BorderColor("#E74C3C"),
+		container.BorderColor(appTheme.Colors().Error),
 		container.BorderWidth(spacing.All(3)),
 		container.BorderStyle(theme.BorderStyleTypeSolid),
 	)
@@ -122,7 +122,7 @@ func backButton() application.BaseWidget {
 						icondata.ChevronLeft,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#FFFFFF"),
+						icon.Fill(appTheme.Colors().OnPrimary),
 					),
 					text.New(
 						"Go Back",
@@ -155,7 +155,7 @@ func homeButton() application.BaseWidget {
 							icondata.Home,
 							icon.Width(breakpoint.All(20)),
 							icon.Height(breakpoint.All(20)),
-							icon.Fill("#FFFFFF"),
+							icon.Fill(appTheme.Colors().OnPrimary),
 						),
 						text.New(
 							"Go Home",
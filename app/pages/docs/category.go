@@ -0,0 +1,129 @@
+package docs
+
+import (
+	"fmt"
+
+	"github.com/gofred-io/gofred-website/app/components/docpage"
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
+	"github.com/gofred-io/gofred-website/app/search"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/grid"
+	"github.com/gofred-io/gofred/foundation/icon"
+	"github.com/gofred-io/gofred/foundation/link"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+)
+
+// categoryPage is the overview of a sidebar section, with a card for each
+// of its pages
+func categoryPage(section registry.Section) application.BaseWidget {
+	cards := make([]application.BaseWidget, 0, len(section.Pages))
+	for _, page := range section.Pages {
+		cards = append(cards, categoryCard(page))
+	}
+
+	return docpage.Page(
+		docpage.Header(section.Category.Title, docpage.Text(section.Category.Description)),
+		grid.New(
+			cards,
+			grid.RowGap(16),
+			grid.ColumnGap(16),
+			grid.ColumnCount(
+				breakpoint.All(2),
+				breakpoint.XS(1),
+				breakpoint.SM(1),
+			),
+		),
+	)
+}
+
+func categoryCard(page registry.Page) application.BaseWidget {
+	details := []application.BaseWidget{
+		statusBadge(page.Available()),
+	}
+	if minutes := search.ReadingTime(page.Href()); minutes > 0 {
+		details = append(details, text.New(
+			fmt.Sprintf("%d min read", minutes),
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+			text.FontSize(12),
+			text.UserSelect(theme.UserSelectTypeNone),
+		))
+	}
+
+	return link.New(
+		container.New(
+			column.New(
+				[]application.BaseWidget{
+					row.New(
+						[]application.BaseWidget{
+							icon.New(
+								page.Icon,
+								icon.Width(breakpoint.All(20)),
+								icon.Height(breakpoint.All(20)),
+								icon.Fill(appTheme.Colors().Accent),
+							),
+							text.New(
+								page.Title,
+								text.FontSize(16),
+								text.FontWeight("700"),
+								text.UserSelect(theme.UserSelectTypeNone),
+							),
+						},
+						row.Gap(8),
+						row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+					),
+					text.New(
+						page.Description,
+						text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+						text.FontSize(14),
+						text.LineHeight(1.5),
+						text.UserSelect(theme.UserSelectTypeNone),
+					),
+					row.New(
+						details,
+						row.Gap(12),
+						row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+					),
+				},
+				column.Gap(12),
+				column.Flex(1),
+			),
+			container.Padding(breakpoint.All(spacing.All(20))),
+			container.BorderRadius(12),
+			container.BorderWidth(spacing.All(1)),
+			container.BorderStyle(theme.BorderStyleTypeSolid),
+			container.Flex(1),
+		),
+		link.Href(page.Href()),
+		link.Label(page.Title),
+	)
+}
+
+// statusBadge tells available pages from the ones still coming soon
+func statusBadge(available bool) application.BaseWidget {
+	colors := appTheme.Colors()
+	label, color, background := "Available", colors.SuccessText, colors.SuccessSurface
+	if !available {
+		label, color, background = "Coming soon", colors.Muted, colors.Surface
+	}
+
+	return container.New(
+		text.New(
+			label,
+			text.FontSize(12),
+			text.FontWeight("500"),
+			text.FontColor(color),
+			text.UserSelect(theme.UserSelectTypeNone),
+		),
+		container.Padding(breakpoint.All(spacing.Axis(8, 2))),
+		container.BorderRadius(12),
+		container.BackgroundColor(background),
+	)
+}
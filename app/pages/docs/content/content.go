@@ -0,0 +1,10 @@
+// Package content holds the markdown sources of the docs pages.
+//
+// By default the pages are compiled ahead of time by cmd/docsgen into Go
+// packages next to this one, which keeps the markdown parser out of the
+// WebAssembly binary. Building with the docs_runtime tag embeds the
+// markdown files and renders them on navigation instead, which is handy
+// while writing a page.
+package content
+
+//go:generate go run github.com/gofred-io/gofred-website/cmd/docsgen
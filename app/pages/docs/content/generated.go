@@ -0,0 +1,17 @@
+//go:build !docs_runtime
+
+package content
+
+import (
+	"github.com/gofred-io/gofred/application"
+)
+
+// Page returns the generated content constructor for the markdown file at path.
+// A path without generated code panics on startup; run go generate to fix it.
+func Page(path string) func() application.BaseWidget {
+	content, ok := generated[path]
+	if !ok {
+		panic("content: no generated page for " + path + ", run go generate ./app/pages/docs/content")
+	}
+	return content
+}
@@ -0,0 +1,106 @@
+//go:build docs_runtime
+
+package content
+
+import (
+	"github.com/gofred-io/gofred-website/app/components/codeblock"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
+	"github.com/gofred-io/gofred-website/app/markdown"
+	"github.com/gofred-io/gofred-website/app/markdown/layout"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/foundation/spacer"
+)
+
+// render builds the same widgets cmd/docsgen writes out for a page
+func render(doc markdown.Document) application.BaseWidget {
+	page := layout.Build(doc)
+
+	var body []application.BaseWidget
+	for _, element := range page.Elements {
+		switch element.Kind {
+		case layout.Section:
+			if len(body) > 0 {
+				body = append(body, spacer.New(spacer.Height(24)))
+			}
+			body = append(body, docpage.Section(element.Title, spans(element.Description)...))
+		case layout.Subsection:
+			if len(body) > 0 {
+				body = append(body, spacer.New(spacer.Height(16)))
+			}
+			body = append(body, docpage.Subsection(element.Title, spans(element.Description)...))
+		case layout.Paragraph:
+			body = append(body, docpage.Paragraph(spans(element.Inlines)...))
+		case layout.CheckList:
+			body = append(body, docpage.CheckList(items(element.Items)...))
+		case layout.OrderedList:
+			body = append(body, docpage.OrderedList(element.Start, items(element.Items)...))
+		case layout.TermList:
+			var terms []docpage.Term
+			for _, item := range element.Items {
+				terms = append(terms, docpage.Term{Term: item.Title, Description: item.Description})
+			}
+			body = append(body, docpage.TermList(terms...))
+		case layout.NextSteps:
+			var steps []docpage.Step
+			for _, item := range element.Items {
+				steps = append(steps, docpage.Step{Title: item.Title, Description: item.Description, Href: item.Href})
+			}
+			body = append(body, docpage.NextSteps(steps...))
+		case layout.Code:
+			body = append(body, codeblock.Build(codeBlock(element)))
+		case layout.CodeGroup:
+			var tabs []codeblock.Tab
+			for _, tab := range element.Tabs {
+				tabs = append(tabs, codeblock.Tab{Label: tab.Options.Tab, Block: codeBlock(tab)})
+			}
+			body = append(body, codeblock.Group(tabs...))
+		}
+	}
+
+	return docpage.Page(
+		docpage.Header(page.Title, spans(page.Description)...),
+		body...,
+	)
+}
+
+func spans(inlines []markdown.Inline) []docpage.Span {
+	var result []docpage.Span
+	for _, inline := range inlines {
+		switch inline.Kind {
+		case markdown.InlineCode:
+			result = append(result, docpage.Code(inline.Text))
+		case markdown.InlineLink:
+			result = append(result, docpage.Link(inline.Text, inline.Href))
+		case markdown.InlineStrong:
+			result = append(result, docpage.Strong(inline.Text))
+		case markdown.InlineEmphasis:
+			result = append(result, docpage.Emphasis(inline.Text))
+		default:
+			result = append(result, docpage.Text(inline.Text))
+		}
+	}
+	return result
+}
+
+func items(listItems []layout.Item) []docpage.Spans {
+	var result []docpage.Spans
+	for _, item := range listItems {
+		result = append(result, spans(item.Inlines))
+	}
+	return result
+}
+
+func codeBlock(element layout.Element) codeblock.Block {
+	block := codeblock.Block{
+		Code:        element.Code,
+		Language:    element.Lang,
+		Title:       element.Options.Title,
+		LineNumbers: element.Options.LineNumbers,
+		Diff:        element.Options.Diff,
+	}
+	for _, lines := range element.Options.Lines {
+		block.Highlight = append(block.Highlight, codeblock.Range{From: lines.From, To: lines.To})
+	}
+	return block
+}
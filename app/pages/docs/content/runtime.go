@@ -0,0 +1,30 @@
+//go:build docs_runtime
+
+package content
+
+import (
+	"embed"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+
+	"github.com/gofred-io/gofred/application"
+)
+
+var (
+	//go:embed getting_started/*.md
+	files embed.FS
+)
+
+// Page returns a content constructor for the embedded markdown file at path.
+// The file is read immediately so a missing page fails on startup rather
+// than on navigation.
+func Page(path string) func() application.BaseWidget {
+	source, err := files.ReadFile(path)
+	if err != nil {
+		panic("content: " + err.Error())
+	}
+
+	return func() application.BaseWidget {
+		return render(markdown.Parse(source))
+	}
+}
@@ -2,6 +2,7 @@ package core_concepts
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/codeblock"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -67,7 +68,6 @@ func eventHandlingPageContent() application.BaseWidget {
 import (
     "fmt"
     "github.com/gofred-io/gofred/foundation/button"
-    "github.com/gofred-io/gofred/foundation/column"
     "github.com/gofred-io/gofred/foundation/text"
     "github.com/gofred-io/gofred/application"
 )
@@ -100,6 +100,7 @@ func inlineHandlerButton() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Event Handler Patterns", "Different patterns for organizing and implementing event handlers."),
+			//samplecheck:fragment
 			codeblock.New(`// Named handler functions (recommended for reusability)
 func saveButton() application.BaseWidget {
     return button.New(
@@ -147,6 +148,7 @@ func (tl *TodoList) handleAddItem(this application.BaseWidget, e application.Eve
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("State Updates from Events", "Update application state in response to user interactions."),
+			//samplecheck:fragment
 			codeblock.New(`import (
     "github.com/gofred-io/gofred/hooks"
     "github.com/gofred-io/gofred/listenable"
@@ -201,6 +203,7 @@ func counterWidget() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Conditional Event Handling", "Handle events differently based on current state."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     isLoggedIn, setIsLoggedIn = hooks.UseState(false)
     user, setUser = hooks.UseState[*User](nil)
@@ -255,6 +258,7 @@ func handleLogout(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Form Events and Validation", "Handle form submissions and input validation."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     formData, setFormData = hooks.UseState(map[string]string{
         "email":    "",
@@ -356,6 +360,7 @@ func handleFormSubmit(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Event Delegation and Bubbling", "Handle events from multiple similar widgets efficiently."),
+			//samplecheck:fragment
 			codeblock.New(`type TodoItem struct {
     ID        string
     Text      string
@@ -456,10 +461,11 @@ func deleteTodo(id string) {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Async Event Handling", "Handle events that trigger asynchronous operations."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     userData, setUserData = hooks.UseState[*User](nil)
     isLoading, setIsLoading = hooks.UseState(false)
-    error, setError = hooks.UseState[error](nil)
+    loadError, setLoadError = hooks.UseState[error](nil)
 )
 
 func userProfile() application.BaseWidget {
@@ -480,8 +486,8 @@ func userProfile() application.BaseWidget {
             }),
             
             // Error state
-            listenable.Builder(error, func() application.BaseWidget {
-                err := error.Value()
+            listenable.Builder(loadError, func() application.BaseWidget {
+                err := loadError.Value()
                 if err != nil {
                     return column.New(
                         []application.BaseWidget{
@@ -537,7 +543,7 @@ func handleLoadUser(this application.BaseWidget, e application.Event) {
     }
     
     // Clear previous error
-    setError(nil)
+    setLoadError(nil)
     setIsLoading(true)
     
     // Simulate async API call
@@ -547,7 +553,7 @@ func handleLoadUser(this application.BaseWidget, e application.Event) {
         
         // Simulate random success/failure
         if rand.Float32() > 0.7 {
-            setError(fmt.Errorf("failed to load user data"))
+            setLoadError(fmt.Errorf("failed to load user data"))
             setIsLoading(false)
             return
         }
@@ -563,6 +569,7 @@ func handleLoadUser(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Event Composition and Higher-Order Handlers", "Create reusable event handling patterns."),
+			//samplecheck:fragment
 			codeblock.New(`// Higher-order function for debounced events
 func debounceHandler(delay time.Duration, handler func(application.BaseWidget, application.Event)) func(application.BaseWidget, application.Event) {
     var timer *time.Timer
@@ -658,6 +665,7 @@ func trackedButton() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			eventSubsection("Link Events", "Handle navigation between pages and routes."),
+			//samplecheck:fragment
 			codeblock.New(`import (
     "github.com/gofred-io/gofred/foundation/link"
 )
@@ -744,47 +752,17 @@ func backButton() application.BaseWidget {
 
 			eventContentSection("What's Next?", "Now that you understand event handling, explore these related topics:"),
 			eventHandlingNextStepsList(),
-			spacer.New(spacer.Height(32)),
-			navigationButtons("/docs/state", "/docs/examples"),
 		},
 		column.Gap(16),
 	)
 }
 
 func eventContentSection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(24),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(16),
-			),
-		},
-		column.Gap(8),
-	)
+	return docpage.Section(title, docpage.Text(description))
 }
 
 func eventSubsection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(20),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(14),
-			),
-		},
-		column.Gap(4),
-	)
+	return docpage.Subsection(title, docpage.Text(description))
 }
 
 func eventHandlingBestPracticesList() application.BaseWidget {
@@ -821,7 +799,7 @@ func eventHandlingListItem(itemText string) application.BaseWidget {
 				icondata.Check,
 				icon.Width(breakpoint.All(16)),
 				icon.Height(breakpoint.All(16)),
-				icon.Fill("#10B981"),
+				icon.Fill(appTheme.Colors().Success),
 			),
 			spacer.New(spacer.Width(8)),
 			text.New(
@@ -2,6 +2,7 @@ package core_concepts
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/codeblock"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -62,6 +63,7 @@ func layoutsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Basic Column", "The foundation of vertical layouts."),
+			//samplecheck:fragment
 			codeblock.New(`column.New(
     []application.BaseWidget{
         text.New("Header", text.FontSize(24), text.FontWeight("700")),
@@ -74,6 +76,7 @@ func layoutsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Column Alignment", "Control how items are aligned within the column."),
+			//samplecheck:fragment
 			codeblock.New(`// Center-aligned column
 column.New(
     []application.BaseWidget{
@@ -88,11 +91,12 @@ column.New(
 // Right-aligned column
 column.New(
     items,
-    column.CrossAxisAlignment(options.AxisAlignmentTypeEnd),
+    column.CrossAxisAlignment(theme.AxisAlignmentTypeEnd),
 )`),
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Flexible Columns", "Make columns expand to fill available space."),
+			//samplecheck:fragment
 			codeblock.New(`column.New(
     []application.BaseWidget{
         header(),
@@ -111,6 +115,7 @@ column.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Basic Row", "The foundation of horizontal layouts."),
+			//samplecheck:fragment
 			codeblock.New(`row.New(
     []application.BaseWidget{
         icon.New(icondata.User, icon.Width(breakpoint.All(20))),
@@ -125,6 +130,7 @@ column.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Row Alignment", "Control vertical alignment of items in a row."),
+			//samplecheck:fragment
 			codeblock.New(`// Top-aligned row
 row.New(
     []application.BaseWidget{
@@ -137,17 +143,18 @@ row.New(
 // Bottom-aligned row
 row.New(
     items,
-    row.CrossAxisAlignment(options.AxisAlignmentTypeEnd),
+    row.CrossAxisAlignment(theme.AxisAlignmentTypeEnd),
 )
 
 // Stretch items to same height
 row.New(
     items,
-    row.CrossAxisAlignment(options.AxisAlignmentTypeStretch),
+    row.CrossAxisAlignment(theme.AxisAlignmentTypeStretch),
 )`),
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Flexible Rows", "Distribute space between row items."),
+			//samplecheck:fragment
 			codeblock.New(`row.New(
     []application.BaseWidget{
         container.New(
@@ -172,6 +179,7 @@ row.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Responsive Grid", "Create grids that adapt to screen size."),
+			//samplecheck:fragment
 			codeblock.New(`grid.New(
     []application.BaseWidget{
         productCard("Product 1"),
@@ -194,6 +202,7 @@ row.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Fixed Grid", "Create grids with consistent column counts."),
+			//samplecheck:fragment
 			codeblock.New(`// 3-column grid for desktop dashboard
 grid.New(
     []application.BaseWidget{
@@ -215,6 +224,7 @@ grid.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Basic Container", "Wrap content with padding and styling."),
+			//samplecheck:fragment
 			codeblock.New(`container.New(
     column.New(
         []application.BaseWidget{
@@ -232,6 +242,7 @@ grid.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Responsive Container", "Containers that adapt to screen size."),
+			//samplecheck:fragment
 			codeblock.New(`container.New(
     content,
     container.Padding(
@@ -254,6 +265,7 @@ grid.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Breakpoint System", "Define different behaviors for different screen sizes."),
+			//samplecheck:fragment
 			codeblock.New(`// Available breakpoints:
 // breakpoint.XS   - Extra small (< 640px)
 // breakpoint.SM   - Small (≥ 640px)
@@ -283,6 +295,7 @@ func responsiveLayout() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Mobile-First Design", "Start with mobile layouts and enhance for larger screens."),
+			//samplecheck:fragment
 			codeblock.New(`// Mobile-first navigation
 func navigationBar() application.BaseWidget {
     return container.New(
@@ -327,6 +340,7 @@ func navigationBar() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Header-Content-Footer", "The classic three-section layout."),
+			//samplecheck:fragment
 			codeblock.New(`func appLayout() application.BaseWidget {
     return column.New(
         []application.BaseWidget{
@@ -350,12 +364,13 @@ func navigationBar() application.BaseWidget {
                 container.BackgroundColor("#1F2937"),
             ),
         },
-        column.Height(breakpoint.All(widget.Context().ClientHeight())),
+        column.Flex(1), // Fills the height of the page
     )
 }`),
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Sidebar Layout", "Content with a side navigation panel."),
+			//samplecheck:fragment
 			codeblock.New(`func dashboardLayout() application.BaseWidget {
     return row.New(
         []application.BaseWidget{
@@ -381,12 +396,13 @@ func navigationBar() application.BaseWidget {
                 container.Padding(breakpoint.All(spacing.All(24))),
             ),
         },
-        row.Height(breakpoint.All(widget.Context().ClientHeight())),
+        row.Flex(1), // Fills the height of the page
     )
 }`),
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Card Layout", "Reusable card components for content display."),
+			//samplecheck:fragment
 			codeblock.New(`func cardLayout(title, content string, actions []application.BaseWidget) application.BaseWidget {
     return container.New(
         column.New(
@@ -443,6 +459,7 @@ func navigationBar() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Consistent Spacing", "Use a consistent spacing scale throughout your app."),
+			//samplecheck:fragment
 			codeblock.New(`// Define your spacing scale
 const (
     SpaceXS  = 4
@@ -466,6 +483,7 @@ column.New(
 			spacer.New(spacer.Height(16)),
 
 			layoutSubsection("Spacer Widget", "Use spacers for flexible and fixed spacing."),
+			//samplecheck:fragment
 			codeblock.New(`// Fixed spacing
 spacer.New(spacer.Height(24))
 spacer.New(spacer.Width(16))
@@ -490,47 +508,17 @@ row.New(
 
 			layoutContentSection("What's Next?", "Now that you understand layouts, explore these related topics:"),
 			layoutsNextStepsList(),
-			spacer.New(spacer.Height(32)),
-			navigationButtons("/docs/widgets", "/docs/styling"),
 		},
 		column.Gap(16),
 	)
 }
 
 func layoutContentSection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(24),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(16),
-			),
-		},
-		column.Gap(8),
-	)
+	return docpage.Section(title, docpage.Text(description))
 }
 
 func layoutSubsection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(20),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(14),
-			),
-		},
-		column.Gap(4),
-	)
+	return docpage.Subsection(title, docpage.Text(description))
 }
 
 func layoutBestPracticesList() application.BaseWidget {
@@ -565,7 +553,7 @@ func layoutListItem(itemText string) application.BaseWidget {
 				icondata.Check,
 				icon.Width(breakpoint.All(16)),
 				icon.Height(breakpoint.All(16)),
-				icon.Fill("#10B981"),
+				icon.Fill(appTheme.Colors().Success),
 			),
 			spacer.New(spacer.Width(8)),
 			text.New(
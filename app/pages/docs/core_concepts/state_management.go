@@ -2,6 +2,7 @@ package core_concepts
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/codeblock"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -106,6 +107,7 @@ func counterWidget() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Multiple State Variables", "Manage multiple pieces of state in your application."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     // User interface state
     isLoggedIn, setIsLoggedIn = hooks.UseState(false)
@@ -167,6 +169,7 @@ func handleLogin(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Basic Reactive Components", "Build components that respond to state changes."),
+			//samplecheck:fragment
 			codeblock.New(`// Theme switching example
 var (
     isDarkMode, setIsDarkMode = hooks.UseState(false)
@@ -207,6 +210,7 @@ func themeToggleWidget() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Conditional Rendering", "Show different UI based on state values."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     user, setUser         = hooks.UseState[*User](nil)
     isLoggedIn, setLoggedIn = hooks.UseState(false)
@@ -275,6 +279,7 @@ func userProfileWidget() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Combining Multiple States", "Create complex reactive UIs by combining multiple state variables."),
+			//samplecheck:fragment
 			codeblock.New(`var (
     items, setItems       = hooks.UseState([]TodoItem{})
     filter, setFilter     = hooks.UseState("all") // "all", "active", "completed"
@@ -351,6 +356,7 @@ func filterButton(filterValue, label string) application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("State Composition", "Combine related state variables into cohesive patterns."),
+			//samplecheck:fragment
 			codeblock.New(`// Group related state together
 type AppState struct {
     User     *User
@@ -428,6 +434,7 @@ func settingsPanel() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Async State Management", "Handle asynchronous operations and loading states."),
+			//samplecheck:fragment
 			codeblock.New(`type AsyncState[T any] struct {
     Data    T
     Loading bool
@@ -529,6 +536,7 @@ func userProfileAsyncWidget() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("State Validation", "Implement validation and error handling in your state."),
+			//samplecheck:fragment
 			codeblock.New(`type FormState struct {
     Values map[string]string
     Errors map[string]string
@@ -665,6 +673,11 @@ type AppStore struct {
     Router      RouterState
 }
 
+type User struct {
+    Name  string
+    Email string
+}
+
 type Notification struct {
     ID      string
     Message string
@@ -739,6 +752,7 @@ func AppStoreListenable() listenable.Listenable[AppStore] {
 			spacer.New(spacer.Height(16)),
 
 			stateSubsection("Using Global State", "Access and use global state in your components."),
+			//samplecheck:fragment
 			codeblock.New(`// Using the global store in components
 import "your-app/store"
 
@@ -828,47 +842,17 @@ func themedButton(label string, onClick func(application.BaseWidget, application
 
 			stateContentSection("What's Next?", "Now that you understand state management, explore these related topics:"),
 			stateManagementNextStepsList(),
-			spacer.New(spacer.Height(32)),
-			navigationButtons("/docs/styling", "/docs/events"),
 		},
 		column.Gap(16),
 	)
 }
 
 func stateContentSection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(24),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(16),
-			),
-		},
-		column.Gap(8),
-	)
+	return docpage.Section(title, docpage.Text(description))
 }
 
 func stateSubsection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(20),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(14),
-			),
-		},
-		column.Gap(4),
-	)
+	return docpage.Subsection(title, docpage.Text(description))
 }
 
 func stateManagementBestPracticesList() application.BaseWidget {
@@ -905,7 +889,7 @@ func stateManagementListItem(itemText string) application.BaseWidget {
 				icondata.Check,
 				icon.Width(breakpoint.All(16)),
 				icon.Height(breakpoint.All(16)),
-				icon.Fill("#10B981"),
+				icon.Fill(appTheme.Colors().Success),
 			),
 			spacer.New(spacer.Width(8)),
 			text.New(
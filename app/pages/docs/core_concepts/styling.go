@@ -2,6 +2,7 @@ package core_concepts
 
 import (
 	"github.com/gofred-io/gofred-website/app/components/codeblock"
+	"github.com/gofred-io/gofred-website/app/components/docpage"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -62,6 +63,7 @@ func stylingPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Text Colors", "Apply colors to text for emphasis, hierarchy, and branding."),
+			//samplecheck:fragment
 			codeblock.New(`// Basic text colors
 text.New("Primary Text", text.FontColor("#1F2937"))    // Dark gray
 text.New("Secondary Text", text.FontColor("#6B7280"))  // Medium gray
@@ -79,6 +81,7 @@ text.New("Accent Text", text.FontColor("#7C3AED"))     // Purple accent`),
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Background Colors", "Set background colors for containers, buttons, and other widgets."),
+			//samplecheck:fragment
 			codeblock.New(`// Container backgrounds
 container.New(
     content,
@@ -103,6 +106,7 @@ button.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Icon Colors", "Colorize icons to match your design system."),
+			//samplecheck:fragment
 			codeblock.New(`// Icon colors with fill
 icon.New(
     icondata.Heart,
@@ -122,6 +126,7 @@ icon.New(icondata.AlertTriangle, icon.Fill("#F59E0B")) // Orange warning`),
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Font Sizes", "Use a consistent scale for font sizes throughout your application."),
+			//samplecheck:fragment
 			codeblock.New(`// Heading sizes
 text.New("Large Heading", text.FontSize(48))      // 48px
 text.New("Page Title", text.FontSize(32))         // 32px
@@ -137,6 +142,7 @@ text.New("Caption", text.FontSize(10))            // 10px`),
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Font Weights", "Control text emphasis with different font weights."),
+			//samplecheck:fragment
 			codeblock.New(`text.New("Thin Text", text.FontWeight("100"))
 text.New("Light Text", text.FontWeight("300"))
 text.New("Normal Text", text.FontWeight("400"))     // Default
@@ -148,11 +154,12 @@ text.New("Black Text", text.FontWeight("900"))`),
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Text Alignment & Layout", "Control text alignment and layout properties."),
+			//samplecheck:fragment
 			codeblock.New(`// Text alignment
-text.New("Left Aligned", text.TextAlign(options.TextAlignTypeLeft))
+text.New("Left Aligned", text.TextAlign(theme.TextAlignTypeLeft))
 text.New("Center Aligned", text.TextAlign(theme.TextAlignTypeCenter))
-text.New("Right Aligned", text.TextAlign(options.TextAlignTypeRight))
-text.New("Justified", text.TextAlign(options.TextAlignTypeJustify))
+text.New("Right Aligned", text.TextAlign(theme.TextAlignTypeRight))
+text.New("Justified", text.TextAlign(theme.TextAlignTypeJustify))
 
 // Line height for readability
 text.New(
@@ -163,8 +170,8 @@ text.New(
 )
 
 // Text decorations
-text.New("Underlined Link", text.TextDecoration(options.TextDecorationTypeUnderline))
-text.New("Strike Through", text.TextDecoration(options.TextDecorationTypeLineThrough))`),
+text.New("Underlined Link", text.TextDecoration(theme.TextDecorationTypeUnderline))
+text.New("Strike Through", text.TextDecoration(theme.TextDecorationTypeLineThrough))`),
 			spacer.New(spacer.Height(24)),
 
 			// Spacing System
@@ -172,6 +179,7 @@ text.New("Strike Through", text.TextDecoration(options.TextDecorationTypeLineThr
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Padding", "Add internal spacing to widgets with padding."),
+			//samplecheck:fragment
 			codeblock.New(`// Uniform padding
 container.New(
     content,
@@ -208,6 +216,7 @@ container.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Margins & Gaps", "Control spacing between widgets."),
+			//samplecheck:fragment
 			codeblock.New(`// Column gaps
 column.New(
     []application.BaseWidget{item1, item2, item3},
@@ -246,6 +255,7 @@ column.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Border Styles", "Define borders for containers and interactive elements."),
+			//samplecheck:fragment
 			codeblock.New(`// Basic border
 container.New(
     content,
@@ -278,6 +288,7 @@ button.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Border Radius", "Round corners for modern, friendly interfaces."),
+			//samplecheck:fragment
 			codeblock.New(`// Border radius values
 container.New(content, container.BorderRadius(4))   // Subtle rounding
 container.New(content, container.BorderRadius(8))   // Standard rounding
@@ -306,6 +317,7 @@ button.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Breakpoint-Based Styling", "Apply different styles at different screen sizes."),
+			//samplecheck:fragment
 			codeblock.New(`// Responsive font sizes
 text.New(
     "Responsive Heading",
@@ -347,6 +359,7 @@ text.New(
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Mobile-First Styling", "Start with mobile styles and enhance for larger screens."),
+			//samplecheck:fragment
 			codeblock.New(`// Mobile-first approach
 func responsiveCard() application.BaseWidget {
     return container.New(
@@ -375,6 +388,7 @@ func responsiveCard() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Button Styles", "Create consistent button styling across your application."),
+			//samplecheck:fragment
 			codeblock.New(`// Primary button
 func primaryButton(label string) application.BaseWidget {
     return button.New(
@@ -409,6 +423,7 @@ func dangerButton(label string) application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Card Styles", "Design consistent card components."),
+			//samplecheck:fragment
 			codeblock.New(`// Basic card
 func basicCard(content application.BaseWidget) application.BaseWidget {
     return container.New(
@@ -459,6 +474,7 @@ func statusCard(content application.BaseWidget, status string) application.BaseW
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Form Styling", "Style form elements for better user experience."),
+			//samplecheck:fragment
 			codeblock.New(`// Form container
 func formContainer(children []application.BaseWidget) application.BaseWidget {
     return container.New(
@@ -503,6 +519,7 @@ func errorMessage(message string) application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Color Palette", "Define a consistent color palette."),
+			//samplecheck:fragment
 			codeblock.New(`// Define your color palette
 const (
     // Primary colors
@@ -541,6 +558,7 @@ container.New(content, container.BackgroundColor(BackgroundWhite))`),
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Typography Scale", "Establish a typographic hierarchy."),
+			//samplecheck:fragment
 			codeblock.New(`// Typography scale
 const (
     // Font sizes
@@ -585,6 +603,7 @@ func bodyText(content string) application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			stylingSubsection("Spacing Scale", "Use consistent spacing throughout your application."),
+			//samplecheck:fragment
 			codeblock.New(`// Spacing scale (in pixels)
 const (
     Space1  = 4
@@ -611,10 +630,12 @@ func sectionMargin() application.BaseWidget {
 }
 
 // Use the scale consistently
-container.New(
-    content,
-    container.Padding(breakpoint.All(cardPadding())),
-)`),
+func card(content application.BaseWidget) application.BaseWidget {
+    return container.New(
+        content,
+        container.Padding(breakpoint.All(cardPadding())),
+    )
+}`),
 			spacer.New(spacer.Height(24)),
 
 			// Best Practices
@@ -624,47 +645,17 @@ container.New(
 
 			stylingContentSection("What's Next?", "Now that you understand styling, explore these related topics:"),
 			stylingNextStepsList(),
-			spacer.New(spacer.Height(32)),
-			navigationButtons("/docs/layouts", "/docs/state"),
 		},
 		column.Gap(16),
 	)
 }
 
 func stylingContentSection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(24),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(16),
-			),
-		},
-		column.Gap(8),
-	)
+	return docpage.Section(title, docpage.Text(description))
 }
 
 func stylingSubsection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(20),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(14),
-			),
-		},
-		column.Gap(4),
-	)
+	return docpage.Subsection(title, docpage.Text(description))
 }
 
 func stylingBestPracticesList() application.BaseWidget {
@@ -701,7 +692,7 @@ func stylingListItem(itemText string) application.BaseWidget {
 				icondata.Check,
 				icon.Width(breakpoint.All(16)),
 				icon.Height(breakpoint.All(16)),
-				icon.Fill("#10B981"),
+				icon.Fill(appTheme.Colors().Success),
 			),
 			spacer.New(spacer.Width(8)),
 			text.New(
@@ -1,11 +1,11 @@
 package core_concepts
 
 import (
+	"github.com/gofred-io/gofred-website/app/components/docpage"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
 	"github.com/gofred-io/gofred/breakpoint"
-	"github.com/gofred-io/gofred/foundation/button"
 	codeblock "github.com/gofred-io/gofred/foundation/code_block"
 	"github.com/gofred-io/gofred/foundation/column"
 	"github.com/gofred-io/gofred/foundation/container"
@@ -64,6 +64,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Container", "A flexible container widget that can hold other widgets and apply styling properties like padding, background color, borders, and sizing."),
+			//samplecheck:fragment
 			codeblock.New(`container.New(
     text.New("Hello, World!"),
     container.Padding(breakpoint.All(spacing.All(16))),
@@ -75,6 +76,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Column", "Arranges child widgets vertically. Perfect for creating vertical layouts and forms."),
+			//samplecheck:fragment
 			codeblock.New(`column.New(
     []application.BaseWidget{
         text.New("First Item"),
@@ -87,6 +89,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Row", "Arranges child widgets horizontally. Ideal for creating horizontal layouts and toolbars."),
+			//samplecheck:fragment
 			codeblock.New(`row.New(
     []application.BaseWidget{
         button.New(text.New("Cancel")),
@@ -99,6 +102,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Grid", "Creates a responsive grid layout for organizing widgets in rows and columns."),
+			//samplecheck:fragment
 			codeblock.New(`grid.New(
     []application.BaseWidget{
         cardWidget("Card 1"),
@@ -117,6 +121,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Center", "Centers its child widget both horizontally and vertically."),
+			//samplecheck:fragment
 			codeblock.New(`center.New(
     text.New(
         "Centered Content",
@@ -127,6 +132,7 @@ func widgetsPageContent() application.BaseWidget {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Spacer", "Creates flexible or fixed spacing between widgets."),
+			//samplecheck:fragment
 			codeblock.New(`// Fixed spacing
 spacer.New(spacer.Height(24))
 spacer.New(spacer.Width(16))
@@ -140,6 +146,7 @@ spacer.New()`),
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Text", "Displays text with customizable styling options like font size, color, weight, and alignment."),
+			//samplecheck:fragment
 			codeblock.New(`text.New(
     "Hello, gofred!",
     text.FontSize(18),
@@ -150,6 +157,7 @@ spacer.New()`),
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Icon", "Displays scalable vector icons from the built-in icon library."),
+			//samplecheck:fragment
 			codeblock.New(`icon.New(
     icondata.Home,
     icon.Width(breakpoint.All(24)),
@@ -159,12 +167,13 @@ spacer.New()`),
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Image", "Displays images with support for various formats and responsive sizing."),
+			//samplecheck:fragment
 			codeblock.New(`image.New(
     image.Src("/assets/logo.png"),
     image.Alt("Company Logo"),
     image.Width(breakpoint.All(200)),
     image.Height(breakpoint.All(100)),
-    image.ObjectFit(options.ObjectFitTypeCover),
+    image.ObjectFit(theme.ObjectFitTypeCover),
 )`),
 			spacer.New(spacer.Height(24)),
 
@@ -173,6 +182,7 @@ spacer.New()`),
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Button", "A clickable button widget that can trigger actions and navigate between screens."),
+			//samplecheck:fragment
 			codeblock.New(`button.New(
     text.New("Click Me", text.FontColor("#FFFFFF")),
     button.BackgroundColor("#2B799B"),
@@ -188,6 +198,7 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Icon Button", "A button that contains only an icon, perfect for toolbars and action menus."),
+			//samplecheck:fragment
 			codeblock.New(`iconbutton.New(
     icondata.Settings,
     iconbutton.IconWidth(breakpoint.All(20)),
@@ -200,11 +211,12 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Link", "Creates navigational links that can route to different pages or external URLs."),
+			//samplecheck:fragment
 			codeblock.New(`link.New(
     text.New(
         "Go to Documentation",
         text.FontColor("#2B799B"),
-        text.TextDecoration(options.TextDecorationTypeUnderline),
+        text.TextDecoration(theme.TextDecorationTypeUnderline),
     ),
     link.Href("/docs"),
 )`),
@@ -215,6 +227,7 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Drawer", "A slide-out panel that can contain navigation menus or additional content."),
+			//samplecheck:fragment
 			codeblock.New(`drawer.New(
     drawerContent(), // Your drawer content
     drawer.Width(breakpoint.All(300)),
@@ -225,6 +238,7 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 			spacer.New(spacer.Height(16)),
 
 			widgetSubsection("Router", "Manages navigation and routing in your application."),
+			//samplecheck:fragment
 			codeblock.New(`router.New(
     router.Routes([]router.Route{
         {Path: "/", Handler: homePage},
@@ -236,6 +250,7 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 
 			// Widget Composition
 			contentSection("Widget Composition", "Widgets can be composed together to create complex UI components. Here's an example of building a card component:"),
+			//samplecheck:fragment
 			codeblock.New(`func cardWidget(title, content string) application.BaseWidget {
     return container.New(
         column.New(
@@ -284,47 +299,17 @@ func handleButtonClick(this application.BaseWidget, e application.Event) {
 
 			contentSection("What's Next?", "Now that you understand widgets, explore these related topics:"),
 			widgetsNextStepsList(),
-			spacer.New(spacer.Height(32)),
-			navigationButtons("/docs/project-structure", "/docs/layouts"),
 		},
 		column.Gap(16),
 	)
 }
 
 func contentSection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(24),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(16),
-			),
-		},
-		column.Gap(8),
-	)
+	return docpage.Section(title, docpage.Text(description))
 }
 
 func widgetSubsection(title, description string) application.BaseWidget {
-	return column.New(
-		[]application.BaseWidget{
-			text.New(
-				title,
-				text.FontSize(20),
-				text.FontWeight("700"),
-			),
-			text.New(
-				description,
-				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-				text.FontSize(14),
-			),
-		},
-		column.Gap(4),
-	)
+	return docpage.Subsection(title, docpage.Text(description))
 }
 
 func bestPracticesList() application.BaseWidget {
@@ -357,7 +342,7 @@ func listItem(itemText string) application.BaseWidget {
 				icondata.Check,
 				icon.Width(breakpoint.All(16)),
 				icon.Height(breakpoint.All(16)),
-				icon.Fill("#10B981"),
+				icon.Fill(appTheme.Colors().Success),
 			),
 			spacer.New(spacer.Width(8)),
 			text.New(
@@ -436,7 +421,7 @@ func nextStepItem(title, description, href string) application.BaseWidget {
 						icondata.ChevronRight,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#9CA3AF"),
+						icon.Fill(appTheme.Colors().Subtle),
 					),
 				},
 				row.Gap(12),
@@ -452,64 +437,3 @@ func nextStepItem(title, description, href string) application.BaseWidget {
 		link.Label(title),
 	)
 }
-
-func navigationButtons(previousHref, nextHref string) application.BaseWidget {
-	return row.New(
-		[]application.BaseWidget{
-			link.New(
-				button.New(
-					row.New(
-						[]application.BaseWidget{
-							icon.New(
-								icondata.ChevronLeft,
-								icon.Width(breakpoint.All(16)),
-								icon.Height(breakpoint.All(16)),
-								icon.Fill("#FFFFFF"),
-							),
-							text.New(
-								"Previous",
-								text.TextStyle(appTheme.Data().ButtonTheme.ButtonStyle.Primary.TextStyle),
-								text.FontSize(14),
-								text.FontWeight("500"),
-							),
-						},
-						row.Gap(8),
-						row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
-					),
-					button.Width(breakpoint.All(120)),
-					button.Label("Previous"),
-				),
-				link.Href(previousHref),
-				link.Label("previous page"),
-			),
-			spacer.New(),
-			link.New(
-				button.New(
-					row.New(
-						[]application.BaseWidget{
-							text.New(
-								"Next",
-								text.TextStyle(appTheme.Data().ButtonTheme.ButtonStyle.Primary.TextStyle),
-								text.FontSize(14),
-								text.FontWeight("500"),
-							),
-							icon.New(
-								icondata.ChevronRight,
-								icon.Width(breakpoint.All(16)),
-								icon.Height(breakpoint.All(16)),
-								icon.Fill("#FFFFFF"),
-							),
-						},
-						row.Gap(8),
-						row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
-					),
-					button.Width(breakpoint.All(120)),
-					button.Label("Next"),
-				),
-				link.Href(nextHref),
-				link.Label("next page"),
-			),
-		},
-		row.Flex(1),
-	)
-}
@@ -6,10 +6,11 @@ import (
 	comingsoon "github.com/gofred-io/gofred-website/app/components/coming_soon"
 	"github.com/gofred-io/gofred-website/app/components/footer"
 	"github.com/gofred-io/gofred-website/app/components/header"
+	"github.com/gofred-io/gofred-website/app/components/toc"
 	notfound "github.com/gofred-io/gofred-website/app/pages/404"
-	"github.com/gofred-io/gofred-website/app/pages/docs/core_concepts"
 	"github.com/gofred-io/gofred-website/app/pages/docs/drawer"
-	"github.com/gofred-io/gofred-website/app/pages/docs/getting_started"
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
+	"github.com/gofred-io/gofred-website/app/search"
 
 	"github.com/gofred-io/gofred/application"
 	"github.com/gofred-io/gofred/breakpoint"
@@ -29,63 +30,92 @@ import (
 	"github.com/gofred-io/gofred/theme"
 )
 
+const (
+	maxComingSoonSuggestions = 5
+)
+
 func New(params router.RouteParams) application.BaseWidget {
 	section := params.Get("section")
-
-	switch section {
-	case "":
+	if section == "" {
 		return docsPageTemplate(docsPageContent())
-	case "installation":
-		return docsPageTemplate(getting_started.InstallationContent())
-	case "quick-start":
-		return docsPageTemplate(getting_started.QuickStartContent())
-	case "first-app":
-		return docsPageTemplate(getting_started.FirstAppContent())
-	case "project-structure":
-		return docsPageTemplate(getting_started.ProjectStructureContent())
-	case "widgets":
-		return docsPageTemplate(core_concepts.WidgetsContent())
-	case "layouts":
-		return docsPageTemplate(core_concepts.LayoutsContent())
-	case "styling":
-		return docsPageTemplate(core_concepts.StylingContent())
-	case "state":
-		return docsPageTemplate(core_concepts.StateManagementContent())
-	case "events":
-		return docsPageTemplate(core_concepts.EventHandlingContent())
-	case "buttons", "navigation", "icons", "images", "containers",
-		"routing", "api", "best-practices", "performance", "deployment",
-		"examples", "tutorials", "community", "support":
-		return docsPageTemplate(comingsoon.ComingSoonContent("Coming Soon", []comingsoon.Suggestion{
-			{Title: "Buttons", Description: "Learn about buttons and how to use them", Href: "/docs/buttons"},
-			{Title: "Navigation", Description: "Learn about navigation and how to use it", Href: "/docs/navigation"},
-			{Title: "Icons", Description: "Learn about icons and how to use them", Href: "/docs/icons"},
-			{Title: "Images", Description: "Learn about images and how to use them", Href: "/docs/images"},
-			{Title: "Containers", Description: "Learn about containers and how to use them", Href: "/docs/containers"},
-		}))
-	default:
+	}
+
+	page, ok := registry.Lookup(section)
+	if !ok {
+		if category, ok := registry.LookupSection(section); ok {
+			return docsPageTemplate(categoryPage(category))
+		}
 		return notfound.New(params)
 	}
+
+	if !page.Available() {
+		return docsPageTemplate(withPager(page, comingsoon.ComingSoonContent(page.Title, comingSoonSuggestions(page))))
+	}
+
+	var aside []application.BaseWidget
+	if outline := search.Outline(page.Href()); len(outline) > 0 {
+		aside = append(aside, toc.New(outline))
+	}
+	return docsPageTemplate(withPager(page, page.Content()), aside...)
 }
 
-func docsPageTemplate(content application.BaseWidget) application.BaseWidget {
+// withPager puts the Previous/Next links below the content of a page
+func withPager(page registry.Page, content application.BaseWidget) application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			content,
+			docsPager(page),
+		},
+		column.Gap(16),
+	)
+}
+
+// comingSoonSuggestions lists available pages, preferring the ones in the same category
+func comingSoonSuggestions(page registry.Page) []comingsoon.Suggestion {
+	var related, others []comingsoon.Suggestion
+	for _, available := range registry.Available() {
+		suggestion := comingsoon.Suggestion{
+			Title:       available.Title,
+			Description: available.Description,
+			Href:        available.Href(),
+		}
+		if available.Category == page.Category {
+			related = append(related, suggestion)
+		} else {
+			others = append(others, suggestion)
+		}
+	}
+
+	suggestions := append(related, others...)
+	if len(suggestions) > maxComingSoonSuggestions {
+		suggestions = suggestions[:maxComingSoonSuggestions]
+	}
+	return suggestions
+}
+
+// docsPageTemplate lays out the docs chrome around content, with aside
+// widgets like the table of contents to its right
+func docsPageTemplate(content application.BaseWidget, aside ...application.BaseWidget) application.BaseWidget {
 	return column.New(
 		[]application.BaseWidget{
 			header.Get(),
-			docsMainContent(content),
+			docsMainContent(content, aside),
 			footer.Get(),
 		},
 		column.Flex(1),
 	)
 }
 
-func docsMainContent(content application.BaseWidget) application.BaseWidget {
+func docsMainContent(content application.BaseWidget, aside []application.BaseWidget) application.BaseWidget {
 	return container.New(
 		row.New(
-			[]application.BaseWidget{
-				docsSidebar(),
-				contentArea(content),
-			},
+			append(
+				[]application.BaseWidget{
+					docsSidebar(),
+					contentArea(content),
+				},
+				aside...,
+			),
 			row.Flex(1),
 		),
 		container.Flex(1),
@@ -145,29 +175,29 @@ func featuresSection() application.BaseWidget {
 					icondata.RocketLaunchOutline,
 					"Get Started",
 					"Get up and running in minutes with our simple installation guide and first app tutorial.",
-					"#2B799B",
-					"/docs/installation",
+					appTheme.Colors().Accent,
+					registry.Href(registry.CategoryGettingStarted),
 				),
 				featureCard(
 					icondata.Tools,
 					"Core Concepts",
 					"Learn about widgets, layouts, styling, and state management in gofred applications.",
-					"#10B981",
-					"/docs/core-concepts",
+					appTheme.Colors().Success,
+					registry.Href(registry.CategoryCoreConcepts),
 				),
 				featureCard(
 					icondata.PaletteOutline,
 					"Components",
 					"Explore the rich set of UI components available for building beautiful interfaces.",
-					"#F59E0B",
-					"/docs/components",
+					appTheme.Colors().Warning,
+					registry.Href(registry.CategoryComponents),
 				),
 				featureCard(
 					icondata.Cog,
 					"Advanced",
 					"Master advanced concepts like routing, performance optimization, and deployment strategies.",
-					"#8B5CF6",
-					"/docs/advanced",
+					appTheme.Colors().Highlight,
+					registry.Href(registry.CategoryAdvanced),
 				),
 			},
 			grid.RowGap(24),
@@ -254,7 +284,7 @@ func featuresTitle() application.BaseWidget {
 			text.New(
 				"Follow our step-by-step installation guide to set up your development environment.",
 				text.FontSize(16),
-				text.FontColor("#6B7280"),
+				text.FontColor(appTheme.Colors().Muted),
 				text.UserSelect(theme.UserSelectTypeNone),
 			),
 		},
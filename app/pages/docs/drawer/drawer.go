@@ -1,6 +1,8 @@
 package drawer
 
 import (
+	"github.com/gofred-io/gofred-website/app/components/search"
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -56,7 +58,7 @@ func drawerHeader() application.BaseWidget {
 				spacer.New(),
 				iconbutton.New(
 					icondata.Close,
-					iconbutton.Fill("#6B7280"),
+					iconbutton.Fill(appTheme.Colors().Muted),
 					iconbutton.OnClick(func(this application.BaseWidget, e application.Event) {
 						scaffold.Get().Drawer(Name).Hide()
 					}),
@@ -100,46 +102,19 @@ func drawerNavigation() application.BaseWidget {
 		listenable.Builder(navigate, func() application.BaseWidget {
 			activeHref := navigate.Path()
 
+			sections := []application.BaseWidget{
+				search.Field(),
+				spacer.New(spacer.Height(16)),
+			}
+			for i, section := range registry.Sections() {
+				if i > 0 {
+					sections = append(sections, spacer.New(spacer.Height(16)))
+				}
+				sections = append(sections, drawerNavSection(section, activeHref))
+			}
+
 			return column.New(
-				[]application.BaseWidget{
-					drawerNavSection("Getting Started", []drawerNavItem{
-						{title: "Installation", href: "/docs/installation", icon: icondata.Download},
-						{title: "Quick Start", href: "/docs/quick-start", icon: icondata.RocketLaunchOutline},
-						{title: "Your First App", href: "/docs/first-app", icon: icondata.Application},
-						{title: "Project Structure", href: "/docs/project-structure", icon: icondata.Folder},
-					}, activeHref),
-					spacer.New(spacer.Height(16)),
-					drawerNavSection("Core Concepts", []drawerNavItem{
-						{title: "Widgets", href: "/docs/widgets", icon: icondata.Widgets},
-						{title: "Layouts", href: "/docs/layouts", icon: icondata.Grid},
-						{title: "Styling", href: "/docs/styling", icon: icondata.PaletteOutline},
-						{title: "State Management", href: "/docs/state", icon: icondata.Database},
-						{title: "Event Handling", href: "/docs/events", icon: icondata.Mouse},
-					}, activeHref),
-					spacer.New(spacer.Height(16)),
-					drawerNavSection("Components", []drawerNavItem{
-						{title: "Buttons", href: "/docs/components/buttons", icon: icondata.ButtonPointer},
-						{title: "Navigation", href: "/docs/components/navigation", icon: icondata.Menu},
-						{title: "Icons", href: "/docs/components/icons", icon: icondata.Star},
-						{title: "Images", href: "/docs/components/images", icon: icondata.Image},
-						{title: "Containers", href: "/docs/components/containers", icon: icondata.Package},
-					}, activeHref),
-					spacer.New(spacer.Height(16)),
-					drawerNavSection("Advanced", []drawerNavItem{
-						{title: "Routing", href: "/docs/routing", icon: icondata.SignDirection},
-						{title: "API Reference", href: "/docs/api", icon: icondata.FileDocument},
-						{title: "Best Practices", href: "/docs/best-practices", icon: icondata.ThumbUp},
-						{title: "Performance", href: "/docs/performance", icon: icondata.Speedometer},
-						{title: "Deployment", href: "/docs/deployment", icon: icondata.Server},
-					}, activeHref),
-					spacer.New(spacer.Height(16)),
-					drawerNavSection("Resources", []drawerNavItem{
-						{title: "Examples", href: "/docs/examples", icon: icondata.Lightbulb},
-						{title: "Tutorials", href: "/docs/tutorials", icon: icondata.Book},
-						{title: "Community", href: "/docs/community", icon: icondata.AccountGroup},
-						{title: "Support", href: "/docs/support", icon: icondata.Help},
-					}, activeHref),
-				},
+				sections,
 				column.Gap(8),
 				column.Flex(1),
 			)
@@ -149,26 +124,27 @@ func drawerNavigation() application.BaseWidget {
 	)
 }
 
-type drawerNavItem struct {
-	title string
-	href  string
-	icon  icondata.IconData
-}
-
-func drawerNavSection(title string, items []drawerNavItem, activeHref string) application.BaseWidget {
+func drawerNavSection(section registry.Section, activeHref string) application.BaseWidget {
 	var sectionItems []application.BaseWidget
 
-	// Section title
-	sectionItems = append(sectionItems, text.New(
-		title,
-		text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-		text.FontSize(12),
-		text.FontWeight("700"),
-		text.UserSelect(theme.UserSelectTypeNone),
+	// Section title, linking to the category's overview page
+	sectionItems = append(sectionItems, link.New(
+		text.New(
+			section.Category.Title,
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+			text.FontSize(12),
+			text.FontWeight("700"),
+			text.UserSelect(theme.UserSelectTypeNone),
+		),
+		link.Href(section.Category.Href()),
+		link.OnClick(func(this application.BaseWidget, e application.Event) {
+			scaffold.Get().Drawer(Name).Hide()
+		}),
+		link.Label(section.Category.Title),
 	))
 
 	// Section items
-	for _, item := range items {
+	for _, item := range section.Pages {
 		sectionItems = append(sectionItems, drawerNavItemWidget(item, activeHref))
 	}
 
@@ -178,11 +154,11 @@ func drawerNavSection(title string, items []drawerNavItem, activeHref string) ap
 	)
 }
 
-func drawerNavItemWidget(item drawerNavItem, activeHref string) application.BaseWidget {
+func drawerNavItemWidget(item registry.Page, activeHref string) application.BaseWidget {
 	var containerStyle theme_style.ContainerStyle
 	var textStyle theme_style.TextStyle
 
-	if item.href == activeHref {
+	if item.Href() == activeHref {
 		containerStyle = appTheme.Data().BoxTheme.ContainerStyle.Tertiary
 		textStyle = appTheme.Data().TextTheme.TextStyle.Tertiary
 	} else {
@@ -195,14 +171,14 @@ func drawerNavItemWidget(item drawerNavItem, activeHref string) application.Base
 			row.New(
 				[]application.BaseWidget{
 					icon.New(
-						item.icon,
+						item.Icon,
 						icon.Width(breakpoint.All(20)),
 						icon.Height(breakpoint.All(20)),
-						icon.Fill("#9CA3AF"),
+						icon.Fill(appTheme.Colors().Subtle),
 					),
 					spacer.New(spacer.Width(6)),
 					text.New(
-						item.title,
+						item.Title,
 						text.TextStyle(textStyle),
 						text.FontSize(14),
 						text.UserSelect(theme.UserSelectTypeNone),
@@ -215,10 +191,10 @@ func drawerNavItemWidget(item drawerNavItem, activeHref string) application.Base
 			container.Padding(breakpoint.All(spacing.Axis(8, 12))),
 			container.BorderRadius(6),
 		),
-		link.Href(item.href),
+		link.Href(item.Href()),
 		link.OnClick(func(this application.BaseWidget, e application.Event) {
 			scaffold.Get().Drawer(Name).Hide()
 		}),
-		link.Label(item.title),
+		link.Label(item.Title),
 	)
 }
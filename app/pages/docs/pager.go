@@ -0,0 +1,110 @@
+package docs
+
+import (
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/icon"
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+	"github.com/gofred-io/gofred/foundation/link"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/spacer"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+)
+
+// docsPager links to the neighbouring available pages in sidebar order
+func docsPager(page registry.Page) application.BaseWidget {
+	previous, next := registry.Neighbours(page.Slug)
+	if previous == nil && next == nil {
+		return spacer.New()
+	}
+
+	var previousCard, nextCard application.BaseWidget = spacer.New(), spacer.New()
+	if previous != nil {
+		previousCard = pagerCard(*previous, "Previous", false)
+	}
+	if next != nil {
+		nextCard = pagerCard(*next, "Next", true)
+	}
+
+	return container.New(
+		row.New(
+			[]application.BaseWidget{
+				previousCard,
+				nextCard,
+			},
+			row.Gap(16),
+			row.Flex(1),
+		),
+		container.Padding(breakpoint.All(spacing.All(32))),
+		container.BorderWidth(spacing.Top(1)),
+		container.BorderStyle(theme.BorderStyleTypeSolid),
+	)
+}
+
+func pagerCard(page registry.Page, label string, isNext bool) application.BaseWidget {
+	alignment := theme.AxisAlignmentTypeStart
+	if isNext {
+		alignment = theme.AxisAlignmentTypeEnd
+	}
+
+	labels := column.New(
+		[]application.BaseWidget{
+			text.New(
+				label,
+				text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+				text.FontSize(12),
+				text.FontWeight("500"),
+				text.UserSelect(theme.UserSelectTypeNone),
+			),
+			text.New(
+				page.Title,
+				text.FontSize(16),
+				text.FontWeight("700"),
+				text.UserSelect(theme.UserSelectTypeNone),
+			),
+		},
+		column.Gap(4),
+		column.Flex(1),
+		column.CrossAxisAlignment(alignment),
+	)
+
+	chevron := icondata.ChevronLeft
+	if isNext {
+		chevron = icondata.ChevronRight
+	}
+	arrow := icon.New(
+		chevron,
+		icon.Width(breakpoint.All(20)),
+		icon.Height(breakpoint.All(20)),
+		icon.Fill(appTheme.Colors().Muted),
+	)
+
+	children := []application.BaseWidget{arrow, labels}
+	if isNext {
+		children = []application.BaseWidget{labels, arrow}
+	}
+
+	return link.New(
+		container.New(
+			row.New(
+				children,
+				row.Gap(12),
+				row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+			),
+			container.Padding(breakpoint.All(spacing.All(16))),
+			container.BorderRadius(8),
+			container.BorderWidth(spacing.All(1)),
+			container.BorderStyle(theme.BorderStyleTypeSolid),
+		),
+		link.Href(page.Href()),
+		link.Label(label+" page: "+page.Title),
+		link.Flex(1),
+	)
+}
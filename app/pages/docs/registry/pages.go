@@ -0,0 +1,256 @@
+package registry
+
+import (
+	"github.com/gofred-io/gofred-website/app/pages/docs/content"
+	"github.com/gofred-io/gofred-website/app/pages/docs/core_concepts"
+
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+)
+
+const (
+	CategoryGettingStarted = "getting-started"
+	CategoryCoreConcepts   = "core-concepts"
+	CategoryComponents     = "components"
+	CategoryAdvanced       = "advanced"
+	CategoryResources      = "resources"
+)
+
+var (
+	categories = []Category{
+		{
+			ID:          CategoryGettingStarted,
+			Title:       "Getting Started",
+			Description: "Install gofred, build your first app and find your way around a project.",
+			Order:       1,
+		},
+		{
+			ID:          CategoryCoreConcepts,
+			Title:       "Core Concepts",
+			Description: "Learn about widgets, layouts, styling, state and events in gofred applications.",
+			Order:       2,
+		},
+		{
+			ID:          CategoryComponents,
+			Title:       "Components",
+			Description: "Explore the UI components available for building interfaces.",
+			Order:       3,
+		},
+		{
+			ID:          CategoryAdvanced,
+			Title:       "Advanced",
+			Description: "Routing, performance, deployment and the practices that keep larger apps maintainable.",
+			Order:       4,
+		},
+		{
+			ID:          CategoryResources,
+			Title:       "Resources",
+			Description: "Examples, tutorials and where to get help from the community.",
+			Order:       5,
+		},
+	}
+
+	pages = []Page{
+		// Getting Started
+		{
+			Slug:        "installation",
+			Title:       "Installation",
+			Description: "Install the gofred CLI and set up your development environment",
+			Category:    CategoryGettingStarted,
+			Order:       1,
+			Icon:        icondata.Download,
+			Content:     content.Page("getting_started/installation.md"),
+		},
+		{
+			Slug:        "quick-start",
+			Title:       "Quick Start",
+			Description: "Create your first gofred application",
+			Category:    CategoryGettingStarted,
+			Order:       2,
+			Icon:        icondata.RocketLaunchOutline,
+			Content:     content.Page("getting_started/quick_start.md"),
+		},
+		{
+			Slug:        "first-app",
+			Title:       "Your First App",
+			Description: "Build a simple application step by step",
+			Category:    CategoryGettingStarted,
+			Order:       3,
+			Icon:        icondata.Application,
+			Content:     content.Page("getting_started/first_app.md"),
+		},
+		{
+			Slug:        "project-structure",
+			Title:       "Project Structure",
+			Description: "Explore how a gofred project is organized",
+			Category:    CategoryGettingStarted,
+			Order:       4,
+			Icon:        icondata.Folder,
+			Content:     content.Page("getting_started/project_structure.md"),
+		},
+
+		// Core Concepts
+		{
+			Slug:        "widgets",
+			Title:       "Widgets",
+			Description: "Learn about the core building blocks of gofred applications",
+			Category:    CategoryCoreConcepts,
+			Order:       1,
+			Icon:        icondata.Widgets,
+			Content:     core_concepts.WidgetsContent,
+		},
+		{
+			Slug:        "layouts",
+			Title:       "Layouts",
+			Description: "Master responsive layouts and component organization",
+			Category:    CategoryCoreConcepts,
+			Order:       2,
+			Icon:        icondata.Grid,
+			Content:     core_concepts.LayoutsContent,
+		},
+		{
+			Slug:        "styling",
+			Title:       "Styling",
+			Description: "Create beautiful interfaces with colors, typography, and spacing",
+			Category:    CategoryCoreConcepts,
+			Order:       3,
+			Icon:        icondata.PaletteOutline,
+			Content:     core_concepts.StylingContent,
+		},
+		{
+			Slug:        "state",
+			Title:       "State Management",
+			Description: "Handle dynamic data and create reactive user interfaces",
+			Category:    CategoryCoreConcepts,
+			Order:       4,
+			Icon:        icondata.Database,
+			Content:     core_concepts.StateManagementContent,
+		},
+		{
+			Slug:        "events",
+			Title:       "Event Handling",
+			Description: "Respond to user interactions and build event-driven applications",
+			Category:    CategoryCoreConcepts,
+			Order:       5,
+			Icon:        icondata.Mouse,
+			Content:     core_concepts.EventHandlingContent,
+		},
+
+		// Components
+		{
+			Slug:        "buttons",
+			Title:       "Buttons",
+			Description: "Learn about buttons and how to use them",
+			Category:    CategoryComponents,
+			Order:       1,
+			Icon:        icondata.ButtonPointer,
+		},
+		{
+			Slug:        "navigation",
+			Title:       "Navigation",
+			Description: "Learn about navigation and how to use it",
+			Category:    CategoryComponents,
+			Order:       2,
+			Icon:        icondata.Menu,
+		},
+		{
+			Slug:        "icons",
+			Title:       "Icons",
+			Description: "Learn about icons and how to use them",
+			Category:    CategoryComponents,
+			Order:       3,
+			Icon:        icondata.Star,
+		},
+		{
+			Slug:        "images",
+			Title:       "Images",
+			Description: "Learn about images and how to use them",
+			Category:    CategoryComponents,
+			Order:       4,
+			Icon:        icondata.Image,
+		},
+		{
+			Slug:        "containers",
+			Title:       "Containers",
+			Description: "Learn about containers and how to use them",
+			Category:    CategoryComponents,
+			Order:       5,
+			Icon:        icondata.Package,
+		},
+
+		// Advanced
+		{
+			Slug:        "routing",
+			Title:       "Routing",
+			Description: "Map URLs to pages with the gofred router",
+			Category:    CategoryAdvanced,
+			Order:       1,
+			Icon:        icondata.SignDirection,
+		},
+		{
+			Slug:        "api",
+			Title:       "API Reference",
+			Description: "Complete widget and option reference",
+			Category:    CategoryAdvanced,
+			Order:       2,
+			Icon:        icondata.FileDocument,
+		},
+		{
+			Slug:        "best-practices",
+			Title:       "Best Practices",
+			Description: "Patterns for maintainable gofred applications",
+			Category:    CategoryAdvanced,
+			Order:       3,
+			Icon:        icondata.ThumbUp,
+		},
+		{
+			Slug:        "performance",
+			Title:       "Performance",
+			Description: "Keep your WebAssembly apps fast and small",
+			Category:    CategoryAdvanced,
+			Order:       4,
+			Icon:        icondata.Speedometer,
+		},
+		{
+			Slug:        "deployment",
+			Title:       "Deployment",
+			Description: "Ship your gofred application to production",
+			Category:    CategoryAdvanced,
+			Order:       5,
+			Icon:        icondata.Server,
+		},
+
+		// Resources
+		{
+			Slug:        "examples",
+			Title:       "Examples",
+			Description: "Real-world usage patterns and sample apps",
+			Category:    CategoryResources,
+			Order:       1,
+			Icon:        icondata.Lightbulb,
+		},
+		{
+			Slug:        "tutorials",
+			Title:       "Tutorials",
+			Description: "Step-by-step guides for common tasks",
+			Category:    CategoryResources,
+			Order:       2,
+			Icon:        icondata.Book,
+		},
+		{
+			Slug:        "community",
+			Title:       "Community",
+			Description: "Get involved with the gofred community",
+			Category:    CategoryResources,
+			Order:       3,
+			Icon:        icondata.AccountGroup,
+		},
+		{
+			Slug:        "support",
+			Title:       "Support",
+			Description: "Find help when you get stuck",
+			Category:    CategoryResources,
+			Order:       4,
+			Icon:        icondata.Help,
+		},
+	}
+)
@@ -0,0 +1,171 @@
+package registry
+
+import (
+	"sort"
+
+	"github.com/gofred-io/gofred/application"
+	icondata "github.com/gofred-io/gofred/foundation/icon/icon_data"
+)
+
+const (
+	BasePath = "/docs"
+)
+
+// Category groups related docs pages in the sidebar and drawer. Its
+// overview page is served at the route of its ID, like /docs/components.
+type Category struct {
+	ID          string
+	Title       string
+	Description string
+	Order       int
+}
+
+// Page describes a single docs page reachable at /docs/:section
+type Page struct {
+	Slug        string
+	Title       string
+	Description string
+	Category    string
+	Order       int
+	Icon        icondata.IconData
+
+	// Content builds the page body. Pages without content are rendered
+	// with the coming soon placeholder.
+	Content func() application.BaseWidget
+}
+
+// Section groups the pages of a category in display order
+type Section struct {
+	Category Category
+	Pages    []Page
+}
+
+// Href returns the route of the category's overview page
+func (c Category) Href() string {
+	return Href(c.ID)
+}
+
+// Href returns the route of the page
+func (p Page) Href() string {
+	return Href(p.Slug)
+}
+
+// Available reports whether the page has real content
+func (p Page) Available() bool {
+	return p.Content != nil
+}
+
+// Href returns the route of the docs page with the given slug
+func Href(slug string) string {
+	if slug == "" {
+		return BasePath
+	}
+	return BasePath + "/" + slug
+}
+
+// Lookup returns the page registered with the given slug
+func Lookup(slug string) (Page, bool) {
+	for _, page := range pages {
+		if page.Slug == slug {
+			return page, true
+		}
+	}
+	return Page{}, false
+}
+
+// Get returns the page registered with the given slug and panics if there is none
+func Get(slug string) Page {
+	page, ok := Lookup(slug)
+	if !ok {
+		panic("registry: unknown docs page " + slug)
+	}
+	return page
+}
+
+// LookupSection returns the category with the given ID and its pages
+func LookupSection(id string) (Section, bool) {
+	for _, category := range categories {
+		if category.ID == id {
+			return Section{Category: category, Pages: PagesIn(id)}, true
+		}
+	}
+	return Section{}, false
+}
+
+// Categories returns every category in display order
+func Categories() []Category {
+	sorted := append([]Category(nil), categories...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+	return sorted
+}
+
+// Pages returns every registered page ordered by category and page order
+func Pages() []Page {
+	var sorted []Page
+	for _, section := range Sections() {
+		sorted = append(sorted, section.Pages...)
+	}
+	return sorted
+}
+
+// Sections returns the pages of every category in display order
+func Sections() []Section {
+	var sections []Section
+	for _, category := range Categories() {
+		sections = append(sections, Section{
+			Category: category,
+			Pages:    PagesIn(category.ID),
+		})
+	}
+	return sections
+}
+
+// PagesIn returns the pages of a single category in display order
+func PagesIn(categoryID string) []Page {
+	var categoryPages []Page
+	for _, page := range pages {
+		if page.Category == categoryID {
+			categoryPages = append(categoryPages, page)
+		}
+	}
+	sort.SliceStable(categoryPages, func(i, j int) bool {
+		return categoryPages[i].Order < categoryPages[j].Order
+	})
+	return categoryPages
+}
+
+// Available returns every page with real content in display order
+func Available() []Page {
+	var available []Page
+	for _, page := range Pages() {
+		if page.Available() {
+			available = append(available, page)
+		}
+	}
+	return available
+}
+
+// Neighbours returns the available pages before and after the page with the
+// given slug in sidebar order, across categories. Either is nil at the ends.
+func Neighbours(slug string) (previous, next *Page) {
+	all := Pages()
+	for i, page := range all {
+		if page.Slug != slug {
+			continue
+		}
+		for j := i - 1; j >= 0 && previous == nil; j-- {
+			if all[j].Available() {
+				previous = &all[j]
+			}
+		}
+		for j := i + 1; j < len(all) && next == nil; j++ {
+			if all[j].Available() {
+				next = &all[j]
+			}
+		}
+		break
+	}
+	return previous, next
+}
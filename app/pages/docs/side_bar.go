@@ -1,6 +1,7 @@
 package docs
 
 import (
+	"github.com/gofred-io/gofred-website/app/pages/docs/registry"
 	appTheme "github.com/gofred-io/gofred-website/app/theme"
 
 	"github.com/gofred-io/gofred/application"
@@ -64,89 +65,38 @@ func navigationMenu() application.BaseWidget {
 	return listenable.Builder(navigate, func() application.BaseWidget {
 		activeHref := navigate.Path()
 
+		var sections []application.BaseWidget
+		for i, section := range registry.Sections() {
+			if i > 0 {
+				sections = append(sections, spacer.New(spacer.Height(16)))
+			}
+			sections = append(sections, navSection(section, activeHref))
+		}
+
 		return column.New(
-			[]application.BaseWidget{
-				navSection(
-					"Getting Started",
-					[]navItem{
-						{title: "Installation", href: "/docs/installation"},
-						{title: "Quick Start", href: "/docs/quick-start"},
-						{title: "Your First App", href: "/docs/first-app"},
-						{title: "Project Structure", href: "/docs/project-structure"},
-					},
-					activeHref,
-				),
-				spacer.New(spacer.Height(16)),
-				navSection(
-					"Core Concepts",
-					[]navItem{
-						{title: "Widgets", href: "/docs/widgets"},
-						{title: "Layouts", href: "/docs/layouts"},
-						{title: "Styling", href: "/docs/styling"},
-						{title: "State Management", href: "/docs/state"},
-						{title: "Event Handling", href: "/docs/events"},
-					},
-					activeHref,
-				),
-				spacer.New(spacer.Height(16)),
-				navSection(
-					"Components",
-					[]navItem{
-						{title: "Buttons", href: "/docs/buttons"},
-						{title: "Navigation", href: "/docs/navigation"},
-						{title: "Icons", href: "/docs/icons"},
-						{title: "Images", href: "/docs/images"},
-						{title: "Containers", href: "/docs/containers"},
-					},
-					activeHref,
-				),
-				spacer.New(spacer.Height(16)),
-				navSection(
-					"Advanced",
-					[]navItem{
-						{title: "Routing", href: "/docs/routing"},
-						{title: "API Reference", href: "/docs/api"},
-						{title: "Best Practices", href: "/docs/best-practices"},
-						{title: "Performance", href: "/docs/performance"},
-						{title: "Deployment", href: "/docs/deployment"},
-					},
-					activeHref,
-				),
-				spacer.New(spacer.Height(16)),
-				navSection(
-					"Resources",
-					[]navItem{
-						{title: "Examples", href: "/docs/examples"},
-						{title: "Tutorials", href: "/docs/tutorials"},
-						{title: "Community", href: "/docs/community"},
-						{title: "Support", href: "/docs/support"},
-					},
-					activeHref,
-				),
-			},
+			sections,
 			column.Gap(8),
 		)
 	})
 }
 
-type navItem struct {
-	title string
-	href  string
-}
-
-func navSection(title string, items []navItem, activeHref string) application.BaseWidget {
+func navSection(section registry.Section, activeHref string) application.BaseWidget {
 	var sectionItems []application.BaseWidget
 
-	// Section title
-	sectionItems = append(sectionItems, text.New(
-		title,
-		text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
-		text.FontSize(14),
-		text.FontWeight("700"),
+	// Section title, linking to the category's overview page
+	sectionItems = append(sectionItems, link.New(
+		text.New(
+			section.Category.Title,
+			text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+			text.FontSize(14),
+			text.FontWeight("700"),
+		),
+		link.Href(section.Category.Href()),
+		link.Label(section.Category.Title),
 	))
 
 	// Section items
-	for _, item := range items {
+	for _, item := range section.Pages {
 		sectionItems = append(sectionItems, navItemWidget(item, activeHref))
 	}
 
@@ -156,11 +106,11 @@ func navSection(title string, items []navItem, activeHref string) application.Ba
 	)
 }
 
-func navItemWidget(item navItem, activeHref string) application.BaseWidget {
+func navItemWidget(item registry.Page, activeHref string) application.BaseWidget {
 	var containerStyle theme_style.ContainerStyle
 	var textStyle theme_style.TextStyle
 
-	if item.href == activeHref {
+	if item.Href() == activeHref {
 		containerStyle = appTheme.Data().BoxTheme.ContainerStyle.Tertiary
 		textStyle = appTheme.Data().TextTheme.TextStyle.Tertiary
 	} else {
@@ -171,7 +121,7 @@ func navItemWidget(item navItem, activeHref string) application.BaseWidget {
 	return link.New(
 		container.New(
 			text.New(
-				item.title,
+				item.Title,
 				text.TextStyle(textStyle),
 				text.FontSize(14),
 				text.UserSelect(theme.UserSelectTypeNone),
@@ -180,7 +130,7 @@ func navItemWidget(item navItem, activeHref string) application.BaseWidget {
 			container.Padding(breakpoint.All(spacing.Axis(8, 12))),
 			container.BorderRadius(6),
 		),
-		link.Href(item.href),
-		link.Label(item.title),
+		link.Href(item.Href()),
+		link.Label(item.Title),
 	)
 }
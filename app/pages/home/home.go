@@ -101,12 +101,12 @@ func heroBadge() application.BaseWidget {
 					icondata.RocketLaunchOutline,
 					icon.Width(breakpoint.All(16)),
 					icon.Height(breakpoint.All(16)),
-					icon.Fill("#2B799B"),
+					icon.Fill(appTheme.Colors().Accent),
 				),
 				text.New(
 					"Build web apps with Go",
 					text.FontSize(14),
-					text.FontColor("#2B799B"),
+					text.FontColor(appTheme.Colors().Accent),
 					text.FontWeight("500"),
 				),
 			},
@@ -114,8 +114,8 @@ func heroBadge() application.BaseWidget {
 			row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
 		),
 		container.Padding(breakpoint.All(spacing.All(12))),
-		container.BackgroundColor("#EFF6FF"),
-		container.BorderColor("#2B799B"),
+		container.BackgroundColor(appTheme.Colors().AccentSurface),
+		container.BorderColor(appTheme.Colors().Accent),
 		container.BorderWidth(spacing.All(1)),
 		container.BorderRadius(20),
 		container.BorderStyle(theme.BorderStyleTypeSolid),
@@ -166,7 +166,7 @@ func heroCTAButtons() application.BaseWidget {
 									icondata.ChevronRight,
 									icon.Width(breakpoint.All(16)),
 									icon.Height(breakpoint.All(16)),
-									icon.Fill("#FFFFFF"),
+									icon.Fill(appTheme.Colors().OnPrimary),
 								),
 							},
 							row.Gap(8),
@@ -192,7 +192,7 @@ func heroCTAButtons() application.BaseWidget {
 									icondata.Github,
 									icon.Width(breakpoint.All(16)),
 									icon.Height(breakpoint.All(16)),
-									icon.Fill("#374151"),
+									icon.Fill(appTheme.Colors().OnSecondary),
 								),
 								text.New(
 									"View on GitHub",
@@ -238,21 +238,21 @@ func heroCodePreview() application.BaseWidget {
 								spacer.New(),
 								container.Width(breakpoint.All(12)),
 								container.Height(breakpoint.All(12)),
-								container.BackgroundColor("#FF5F58"),
+								container.BackgroundColor(appTheme.Colors().Error),
 								container.BorderRadius(6),
 							),
 							container.New(
 								spacer.New(),
 								container.Width(breakpoint.All(12)),
 								container.Height(breakpoint.All(12)),
-								container.BackgroundColor("#FFBD2E"),
+								container.BackgroundColor(appTheme.Colors().Warning),
 								container.BorderRadius(6),
 							),
 							container.New(
 								spacer.New(),
 								container.Width(breakpoint.All(12)),
 								container.Height(breakpoint.All(12)),
-								container.BackgroundColor("#28C941"),
+								container.BackgroundColor(appTheme.Colors().Success),
 								container.BorderRadius(6),
 							),
 							spacer.New(),
@@ -341,37 +341,37 @@ func modernFeaturesSection() application.BaseWidget {
 										icondata.RocketLaunchOutline,
 										"Fast Development",
 										"Write web applications in Go with hot reload and instant feedback. No build tools or complex setups required.",
-										"#2B799B",
+										appTheme.Colors().Accent,
 									),
 									modernFeatureCard(
 										icondata.LightningBoltOutline,
 										"High Performance",
 										"Compiled to WebAssembly for near-native performance. Your apps run fast in any modern browser.",
-										"#059669",
+										appTheme.Colors().Success,
 									),
 									modernFeatureCard(
 										icondata.PaletteOutline,
 										"Beautiful UIs",
 										"Create responsive, beautiful interfaces with a widget-based architecture and powerful styling system.",
-										"#7C3AED",
+										appTheme.Colors().Highlight,
 									),
 									modernFeatureCard(
 										icondata.Cellphone,
 										"Mobile Ready",
 										"Built-in responsive design system ensures your apps work perfectly on desktop, tablet, and mobile.",
-										"#DC2626",
+										appTheme.Colors().Error,
 									),
 									modernFeatureCard(
 										icondata.PowerPlugOutline,
 										"Easy Integration",
 										"Seamlessly integrate with existing Go backends and APIs. Leverage your existing Go knowledge and libraries.",
-										"#EA580C",
+										appTheme.Colors().Warning,
 									),
 									modernFeatureCard(
 										icondata.Tools,
 										"Developer Friendly",
 										"Rich developer experience with comprehensive documentation, examples, and active community support.",
-										"#0891B2",
+										appTheme.Colors().Info,
 									),
 								},
 								grid.ColumnCount(
@@ -507,7 +507,7 @@ func gettingStartedSection() application.BaseWidget {
 										text.New(
 											"View Full Tutorial",
 											text.FontSize(16),
-											text.FontColor("#FFFFFF"),
+											text.FontColor(appTheme.Colors().OnPrimary),
 											text.FontWeight("700"),
 											text.Align(theme.TextAlignTypeCenter),
 										),
@@ -515,7 +515,7 @@ func gettingStartedSection() application.BaseWidget {
 											icondata.ChevronRight,
 											icon.Width(breakpoint.All(16)),
 											icon.Height(breakpoint.All(16)),
-											icon.Fill("#FFFFFF"),
+											icon.Fill(appTheme.Colors().OnPrimary),
 										),
 									},
 									row.Gap(8),
@@ -560,13 +560,13 @@ func gettingStartedStep(number, title, description, code string) application.Bas
 					text.New(
 						number,
 						text.FontSize(18),
-						text.FontColor("#FFFFFF"),
+						text.FontColor(appTheme.Colors().OnPrimary),
 						text.FontWeight("700"),
 					),
 				),
 				container.Width(breakpoint.All(40)),
 				container.Height(breakpoint.All(40)),
-				container.BackgroundColor("#1976d2"),
+				container.BackgroundColor(appTheme.Colors().Primary),
 				container.BorderRadius(20),
 				container.Visible(
 					breakpoint.All(true),
@@ -706,7 +706,7 @@ func communityLink(iconData icondata.IconData, title, description, href string,
 											iconData,
 											icon.Width(breakpoint.All(24)),
 											icon.Height(breakpoint.All(24)),
-											icon.Fill("#2B799B"),
+											icon.Fill(appTheme.Colors().Accent),
 										),
 									),
 									container.Padding(breakpoint.All(spacing.All(12))),
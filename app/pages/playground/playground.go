@@ -0,0 +1,126 @@
+// Package playground is the /playground page: an editor for a gofred program
+// next to a preview running it.
+//
+// Programs are compiled by the service at env.js's COMPILE_URL, which
+// cmd/devserver and cmd/playground provide, and run in web/sandbox.html,
+// an iframe sandboxed with allow-scripts only. The page shows the program
+// the URL carries, see package route, or else the last one edited.
+//
+// The toolbar is built from widgets. The editor, preview and output are
+// plain DOM mounted in the page's workspace container and styled by the
+// .playground-* rules in web/index.css: gofred has no text area or frame
+// widget, and the elements are built once and moved into every rendering,
+// so rebuilds keep the text being edited and the program running.
+package playground
+
+import (
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/components/footer"
+	"github.com/gofred-io/gofred-website/app/components/header"
+	appTheme "github.com/gofred-io/gofred-website/app/theme"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/breakpoint"
+	"github.com/gofred-io/gofred/foundation/button"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/container"
+	"github.com/gofred-io/gofred/foundation/router"
+	"github.com/gofred-io/gofred/foundation/row"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/listenable"
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+	style "github.com/gofred-io/gofred/theme/theme_style"
+)
+
+func New(params router.RouteParams) application.BaseWidget {
+	browser.AfterRender(mount)
+
+	return column.New(
+		[]application.BaseWidget{
+			header.Get(),
+			container.New(
+				column.New(
+					[]application.BaseWidget{
+						text.New(
+							"Playground",
+							text.FontSize(32),
+							text.FontWeight("700"),
+						),
+						text.New(
+							"Edit a gofred program and run it in your browser with Run or Ctrl+Enter. It runs in a sandboxed frame, what it prints shows below the preview.",
+							text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+							text.FontSize(16),
+						),
+						toolbar(),
+						container.New(
+							text.New(
+								"Loading the editor…",
+								text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+								text.FontSize(14),
+							),
+							container.ID(workspaceID),
+						),
+					},
+					column.Gap(16),
+				),
+				container.Flex(1),
+				container.Padding(
+					breakpoint.All(spacing.All(32)),
+					breakpoint.XS(spacing.All(16)),
+				),
+			),
+			footer.Get(),
+		},
+		column.Flex(1),
+	)
+}
+
+// toolbar is the Run, Reset and Copy link buttons and the status of the
+// last run
+func toolbar() application.BaseWidget {
+	return row.New(
+		[]application.BaseWidget{
+			listenable.Builder(compiling, func() application.BaseWidget {
+				return toolbarButton("Run", appTheme.Data().ButtonTheme.ButtonStyle.Primary, compiling.Value(), func(w *workspace) {
+					w.start()
+				})
+			}),
+			toolbarButton("Reset", appTheme.Data().ButtonTheme.ButtonStyle.Secondary, false, func(w *workspace) {
+				w.reset()
+			}),
+			toolbarButton("Copy link", appTheme.Data().ButtonTheme.ButtonStyle.Secondary, false, func(w *workspace) {
+				w.copyLink()
+			}),
+			listenable.Builder(status, func() application.BaseWidget {
+				return text.New(
+					status.Value(),
+					text.TextStyle(appTheme.Data().TextTheme.TextStyle.Secondary),
+					text.FontSize(14),
+				)
+			}),
+		},
+		row.Gap(8),
+		row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),
+	)
+}
+
+// toolbarButton calls onClick with the workspace once it is mounted
+func toolbarButton(label string, buttonStyle style.ButtonStyle, disabled bool, onClick func(w *workspace)) application.BaseWidget {
+	return button.New(
+		text.New(
+			label,
+			text.TextStyle(buttonStyle.TextStyle),
+			text.FontSize(14),
+			text.FontWeight("500"),
+		),
+		button.ButtonStyle(buttonStyle),
+		button.Disabled(disabled),
+		button.OnClick(func(this application.BaseWidget, e application.Event) {
+			if ws != nil {
+				onClick(ws)
+			}
+		}),
+		button.Label(label),
+	)
+}
@@ -0,0 +1,35 @@
+// Package route holds the path of the playground page and the links that
+// open a program in it. It imports no widgets, so the code blocks linking to
+// the playground can use it without importing the page.
+package route
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+const (
+	// Path is the route of the playground page
+	Path = "/playground"
+
+	// codeParam is the key of the program in the URL fragment
+	codeParam = "code="
+)
+
+// Href returns the link opening code in the playground
+func Href(code string) string {
+	return Path + "#" + codeParam + base64.RawURLEncoding.EncodeToString([]byte(code))
+}
+
+// Code returns the program a URL fragment, without the #, carries
+func Code(hash string) (string, bool) {
+	encoded, ok := strings.CutPrefix(hash, codeParam)
+	if !ok {
+		return "", false
+	}
+	code, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", false
+	}
+	return string(code), true
+}
@@ -0,0 +1,306 @@
+package playground
+
+import (
+	"strconv"
+	"syscall/js"
+
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/components/snackbar"
+	"github.com/gofred-io/gofred-website/app/constant"
+	"github.com/gofred-io/gofred-website/app/pages/playground/route"
+
+	"github.com/gofred-io/gofred/hooks"
+)
+
+const (
+	// workspaceID is the element ID of the container the workspace is
+	// mounted in
+	workspaceID = "playground-workspace"
+
+	// codeKey is the localStorage key of the program last edited
+	codeKey = "playground-code"
+
+	// sandboxURL is the frame programs run in
+	sandboxURL = "/sandbox.html"
+
+	// noService is shown when env.js names no compile service
+	noService = "Running programs needs a compile service. Serve the site with make serve, " +
+		"or start one with go run ./cmd/playground -allow-origin set to the site's origin and set COMPILE_URL in env.js to its address."
+)
+
+var (
+	// status is shown next to the toolbar buttons, like "Compiling…"
+	status, setStatus = hooks.UseState("")
+
+	// compiling is set while the compile service builds a program, the Run
+	// button is disabled meanwhile
+	compiling, setCompiling = hooks.UseState(false)
+)
+
+// workspace is the editor, preview and output. It is built once and moved
+// into every rendering of the page, so edits survive theme changes and
+// visits to other pages.
+type workspace struct {
+	element js.Value
+	editor  js.Value
+	preview js.Value
+	output  js.Value
+
+	// frame runs the last program compiled, wasm is its module until the
+	// frame is ready for it
+	frame js.Value
+	wasm  js.Value
+
+	// opened is the program the page was opened with, Reset goes back to it
+	opened string
+}
+
+var ws *workspace
+
+// mount puts the workspace in the container of the rendered page
+func mount() {
+	if browser.Path() != route.Path {
+		return
+	}
+	host := browser.Document().Call("getElementById", workspaceID)
+	if host.IsNull() {
+		return
+	}
+
+	if ws == nil {
+		ws = newWorkspace()
+	} else if code, ok := linked(); ok && code != ws.opened {
+		ws.opened = code
+		ws.setCode(code)
+	}
+	host.Call("replaceChildren", ws.element)
+}
+
+// linked returns the program of the URL, if it carries one
+func linked() (string, bool) {
+	return route.Code(browser.Hash())
+}
+
+func newWorkspace() *workspace {
+	w := &workspace{opened: defaultCode}
+	code := browser.Stored(codeKey)
+	if linkedCode, ok := linked(); ok {
+		w.opened, code = linkedCode, linkedCode
+	}
+	if code == "" {
+		code = w.opened
+	}
+
+	w.element = browser.Element("div", "playground")
+	panes := browser.Element("div", "playground-panes")
+	w.editor = browser.Element("textarea", "playground-editor")
+	w.editor.Call("setAttribute", "aria-label", "Program")
+	w.editor.Call("setAttribute", "spellcheck", "false")
+	w.editor.Call("setAttribute", "autocapitalize", "off")
+	w.editor.Call("setAttribute", "autocomplete", "off")
+	w.editor.Call("setAttribute", "wrap", "off")
+	w.editor.Set("value", code)
+	w.preview = browser.Element("div", "playground-preview")
+	empty := browser.Element("div", "playground-empty")
+	empty.Set("textContent", "Run the program to see it here")
+	w.preview.Call("append", empty)
+	panes.Call("append", w.editor, w.preview)
+
+	w.output = browser.Element("pre", "playground-output")
+	w.output.Call("setAttribute", "role", "log")
+	w.output.Call("setAttribute", "aria-label", "Output")
+
+	w.element.Call("append", panes, w.output)
+
+	browser.Listen(w.editor, "input", func(js.Value) {
+		browser.Store(codeKey, w.code())
+	})
+	browser.Listen(w.editor, "keydown", func(event js.Value) {
+		if event.Get("key").String() == "Enter" && (event.Get("ctrlKey").Bool() || event.Get("metaKey").Bool()) {
+			event.Call("preventDefault")
+			w.start()
+		}
+	})
+	browser.Listen(js.Global(), "message", w.receive)
+	return w
+}
+
+func (w *workspace) code() string {
+	return w.editor.Get("value").String()
+}
+
+// reset goes back to the program the page was opened with
+func (w *workspace) reset() {
+	w.setCode(w.opened)
+}
+
+func (w *workspace) setCode(code string) {
+	w.editor.Set("value", code)
+	browser.Store(codeKey, code)
+}
+
+// copyLink copies the link opening the program in the editor
+func (w *workspace) copyLink() {
+	browser.CopyText(browser.Origin()+route.Href(w.code()), func(ok bool) {
+		if ok {
+			snackbar.Show("Link to the program copied to clipboard", constant.SnackbarTypeSuccess)
+		} else {
+			snackbar.Show("Could not copy the link", constant.SnackbarTypeError)
+		}
+	})
+}
+
+// start sends the program to the compile service and runs what comes back
+func (w *workspace) start() {
+	if compiling.Value() {
+		return
+	}
+	w.output.Set("textContent", "")
+	url := browser.Env("COMPILE_URL")
+	if url == "" {
+		setStatus("No compile service")
+		w.print(noService, true)
+		return
+	}
+
+	setCompiling(true)
+	setStatus("Compiling…")
+	request := map[string]any{
+		"method":  "POST",
+		"headers": map[string]any{"Content-Type": "text/plain; charset=utf-8"},
+		"body":    w.code(),
+	}
+	browser.Await(js.Global().Call("fetch", url, request), func(response js.Value, err error) {
+		if err != nil {
+			w.fail("Compile service unreachable", err.Error())
+			return
+		}
+		if !response.Get("ok").Bool() {
+			code := response.Get("status").Int()
+			title := "Compile service failed"
+			if code == 422 {
+				title = "Build failed"
+			}
+			browser.Await(response.Call("text"), func(text js.Value, err error) {
+				if err != nil {
+					w.fail(title, "HTTP "+strconv.Itoa(code))
+					return
+				}
+				w.fail(title, text.String())
+			})
+			return
+		}
+		browser.Await(response.Call("arrayBuffer"), func(wasm js.Value, err error) {
+			if err != nil {
+				w.fail("Compile service failed", err.Error())
+				return
+			}
+			setCompiling(false)
+			w.launch(wasm)
+		})
+	})
+}
+
+func (w *workspace) fail(title, output string) {
+	setCompiling(false)
+	setStatus(title)
+	w.print(output, true)
+}
+
+// launch replaces the frame of the last program with a new one for wasm.
+// The module is sent once the frame says it is ready.
+func (w *workspace) launch(wasm js.Value) {
+	frame := browser.Element("iframe", "playground-frame")
+	frame.Call("setAttribute", "sandbox", "allow-scripts")
+	frame.Set("title", "Program preview")
+	frame.Set("src", sandboxURL)
+	w.frame = frame
+	w.wasm = wasm
+	w.preview.Call("replaceChildren", frame)
+	setStatus("Running")
+}
+
+// receive handles the messages of the frame, see web/sandbox.html
+func (w *workspace) receive(event js.Value) {
+	if !w.frame.Truthy() || !event.Get("source").Equal(w.frame.Get("contentWindow")) {
+		return
+	}
+	data := event.Get("data")
+	if data.Type() != js.TypeObject {
+		return
+	}
+
+	switch data.Get("type").String() {
+	case "ready":
+		if w.wasm.Truthy() {
+			wasm := w.wasm
+			w.wasm = js.Undefined()
+			message := map[string]any{"type": "run", "wasm": wasm}
+			w.frame.Get("contentWindow").Call("postMessage", message, "*", []any{wasm})
+		}
+	case "output":
+		w.print(data.Get("text").String(), false)
+	case "error":
+		setStatus("Program failed")
+		w.print(data.Get("text").String(), true)
+	case "exit":
+		if code := data.Get("code").Int(); code != 0 {
+			setStatus("Program exited with code " + strconv.Itoa(code))
+			return
+		}
+		setStatus("Program exited")
+	}
+}
+
+// print adds a line to the output
+func (w *workspace) print(text string, failed bool) {
+	className := "playground-output-line"
+	if failed {
+		className += " playground-output-line--error"
+	}
+	line := browser.Element("span", className)
+	line.Set("textContent", text+"\n")
+	w.output.Call("append", line)
+	w.output.Set("scrollTop", w.output.Get("scrollHeight"))
+}
+
+// defaultCode is the program shown to first-time visitors
+const defaultCode = `package main
+
+import (
+	"fmt"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/foundation/button"
+	"github.com/gofred-io/gofred/foundation/column"
+	"github.com/gofred-io/gofred/foundation/text"
+	"github.com/gofred-io/gofred/hooks"
+	"github.com/gofred-io/gofred/listenable"
+)
+
+var count, setCount = hooks.UseState(0)
+
+func main() {
+	application.Run(counter())
+}
+
+func counter() application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			listenable.Builder(count, func() application.BaseWidget {
+				return text.New(fmt.Sprintf("Clicked %d times", count.Value()), text.FontSize(18))
+			}),
+			button.New(
+				text.New("Click me"),
+				button.OnClick(func(this application.BaseWidget, e application.Event) {
+					next := count.Value() + 1
+					setCount(next)
+					// Printed lines show below the preview
+					fmt.Println("count is now", next)
+				}),
+			),
+		},
+		column.Gap(12),
+	)
+}
+`
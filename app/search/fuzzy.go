@@ -0,0 +1,66 @@
+package search
+
+// Fuzzy matches query against value as a subsequence, ignoring case and
+// spaces in the query. Matches at the start of words and runs of adjacent
+// characters score higher, so "qs" ranks "Quick Start" above "Styles".
+func Fuzzy(query, value string) (int, []Fragment, bool) {
+	pattern := lower(query)
+	lowered := lower(value)
+
+	matched := make([]bool, len(value))
+	score := 0
+	previous := -2
+	position := 0
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == ' ' {
+			continue
+		}
+
+		index := -1
+		for j := position; j < len(lowered); j++ {
+			if lowered[j] == c {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			return 0, nil, false
+		}
+
+		switch {
+		case index == 0:
+			score += 10
+		case isSeparator(rune(lowered[index-1])):
+			score += 8
+		case index == previous+1:
+			score += 5
+		default:
+			score++
+		}
+		score -= min(index-position, 3)
+
+		matched[index] = true
+		previous = index
+		position = index + 1
+	}
+
+	// Prefer shorter values when the rest is equal
+	score -= len(value) / 16
+
+	return score, fragments(value, matched), true
+}
+
+// fragments splits value into runs of matched and unmatched bytes
+func fragments(value string, matched []bool) []Fragment {
+	var result []Fragment
+	start := 0
+	for i := 1; i <= len(value); i++ {
+		if i == len(value) || matched[i] != matched[start] {
+			result = append(result, Fragment{Text: value[start:i], Match: matched[start]})
+			start = i
+		}
+	}
+	return result
+}
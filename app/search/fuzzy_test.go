@@ -0,0 +1,75 @@
+package search
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestFuzzy(t *testing.T) {
+	tests := []struct {
+		query, value string
+		score        int
+		want         []Fragment
+	}{
+		{"qs", "Quick Start", 15, []Fragment{{Text: "Q", Match: true}, {Text: "uick "}, {Text: "S", Match: true}, {Text: "tart"}}},
+		{"Q S", "quick start", 15, []Fragment{{Text: "q", Match: true}, {Text: "uick "}, {Text: "s", Match: true}, {Text: "tart"}}},
+		{"ins", "Installation", 20, []Fragment{{Text: "Ins", Match: true}, {Text: "tallation"}}},
+		{"ins", "Icons", 14, []Fragment{{Text: "I", Match: true}, {Text: "co"}, {Text: "ns", Match: true}}},
+		{"gs", "Getting Started", 15, []Fragment{{Text: "G", Match: true}, {Text: "etting "}, {Text: "S", Match: true}, {Text: "tarted"}}},
+		{"gs", "Gadgets", 8, []Fragment{{Text: "G", Match: true}, {Text: "adget"}, {Text: "s", Match: true}}},
+		{"", "Home", 0, []Fragment{{Text: "Home"}}},
+	}
+	for _, tt := range tests {
+		score, fragments, ok := Fuzzy(tt.query, tt.value)
+		if !ok {
+			t.Errorf("Fuzzy(%q, %q) does not match", tt.query, tt.value)
+			continue
+		}
+		if score != tt.score || !reflect.DeepEqual(fragments, tt.want) {
+			t.Errorf("Fuzzy(%q, %q) = %d, %+v, want %d, %+v", tt.query, tt.value, score, fragments, tt.score, tt.want)
+		}
+	}
+}
+
+func TestFuzzyNoMatch(t *testing.T) {
+	for _, tt := range []struct{ query, value string }{
+		{"qs", "Styles"},
+		{"sq", "Quick Start"},
+		{"installs", "Installation"},
+		{"a", ""},
+	} {
+		if score, fragments, ok := Fuzzy(tt.query, tt.value); ok {
+			t.Errorf("Fuzzy(%q, %q) = %d, %+v, want no match", tt.query, tt.value, score, fragments)
+		}
+	}
+}
+
+func TestFuzzyOrder(t *testing.T) {
+	tests := []struct {
+		query  string
+		values []string
+		want   []string
+	}{
+		// Word starts, then runs of adjacent characters, then scattered ones
+		{"st", []string{"Lists", "State Management", "Quick Start", "Styling"}, []string{"Styling", "State Management", "Quick Start", "Lists"}},
+		{"ins", []string{"Icons", "Installation"}, []string{"Installation", "Icons"}},
+
+		// Shorter values win when the rest is equal
+		{"go", []string{"Go to the gofred repository on GitHub", "Go"}, []string{"Go", "Go to the gofred repository on GitHub"}},
+	}
+	for _, tt := range tests {
+		scores := map[string]int{}
+		var got []string
+		for _, value := range tt.values {
+			if score, _, ok := Fuzzy(tt.query, value); ok {
+				scores[value] = score
+				got = append(got, value)
+			}
+		}
+		sort.SliceStable(got, func(i, j int) bool { return scores[got[i]] > scores[got[j]] })
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q orders %q, want %q (scores %v)", tt.query, got, tt.want, scores)
+		}
+	}
+}
@@ -0,0 +1,1147 @@
+// Code generated by searchindex. DO NOT EDIT.
+
+package search
+
+var documents = []Document{
+	{
+		Href: "/docs/installation",
+		Page: "Installation",
+		Text: "Install the gofred CLI tool and set up your development environment for Go WebAssembly applications.",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Prerequisites",
+		Anchor:  "prerequisites",
+		Level:   2,
+		Text:    "Before you begin, make sure you have the following installed on your system: Go 1.25.1 or later A modern web browser with WebAssembly support curl, tar, and jq (for installation script) Basic knowledge of Go programming Install Go and the tools the installation script uses with your system's package manager:",
+		Code:    "brew install go jq\nsudo snap install go --classic\nsudo apt-get install -y curl tar jq\nwinget install GoLang.Go\nwinget install jqlang.jq",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Installation",
+		Anchor:  "installation",
+		Level:   2,
+		Text:    "Install the gofred CLI tool using the installation script: This script will detect your operating system and architecture, download the appropriate binary, and install it to ~/.local/bin (or ~/AppData/Local/bin on Windows).",
+		Code:    "curl -fsSL https://raw.githubusercontent.com/gofred-io/gofred-cli/refs/heads/master/install.sh | bash",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Verify Installation",
+		Anchor:  "verify-installation",
+		Level:   2,
+		Text:    "Check that gofred is installed correctly:",
+		Code:    "gofred version",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Create Your First App",
+		Anchor:  "create-your-first-app",
+		Level:   2,
+		Text:    "Create a new Go WebAssembly application: This will create a complete project structure with main.go, web assets, and VS Code configuration.",
+		Code:    "gofred app create my-app --package my-app",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Run Your Application",
+		Anchor:  "run-your-application",
+		Level:   2,
+		Text:    "Navigate to your app directory and start the development server: This will compile your Go code to WebAssembly, start a development server, and automatically open your browser with hot reload enabled.",
+		Code:    "cd my-app\ngofred app run",
+	},
+	{
+		Href:    "/docs/installation",
+		Page:    "Installation",
+		Section: "Next Steps",
+		Anchor:  "next-steps",
+		Level:   2,
+		Text:    "Now that you have gofred installed, you can: Quick Start Create your first gofred application Build Your First App Create a simple application step by step Project Structure Explore the project structure",
+	},
+	{
+		Href: "/docs/quick-start",
+		Page: "Quick Start",
+		Text: "Get started with gofred by creating your first application.",
+	},
+	{
+		Href:    "/docs/quick-start",
+		Page:    "Quick Start",
+		Section: "Hello, gofred!",
+		Anchor:  "hello-gofred",
+		Level:   2,
+		Text:    "Let's start with a simple hello world application:",
+		Code:    "package main\n\nimport (\n    \"github.com/gofred-io/gofred/application\"\n    \"github.com/gofred-io/gofred/foundation/text\"\n)\n\nfunc main() {\n    app := text.New(\"Hello, gofred!\")\n    application.Run(app)\n}",
+	},
+	{
+		Href:    "/docs/quick-start",
+		Page:    "Quick Start",
+		Section: "Next Steps",
+		Anchor:  "next-steps",
+		Level:   2,
+		Text:    "Now that you have gofred installed, you can: Build Your First App Create a simple application step by step Project Structure Explore the project structure",
+	},
+	{
+		Href: "/docs/first-app",
+		Page: "Your First App",
+		Text: "Build a complete gofred application from scratch with step-by-step instructions.",
+	},
+	{
+		Href:    "/docs/first-app",
+		Page:    "Your First App",
+		Section: "Project Setup",
+		Anchor:  "project-setup",
+		Level:   2,
+		Text:    "Let's create a new gofred project and build a simple todo application:",
+		Code:    "mkdir my-gofred-app\ncd my-gofred-app\ngo mod init my-gofred-app\ngo get github.com/gofred-io/gofred",
+	},
+	{
+		Href:    "/docs/first-app",
+		Page:    "Your First App",
+		Section: "Basic Structure",
+		Anchor:  "basic-structure",
+		Level:   2,
+		Text:    "Create the main application file:",
+		Code:    "package main\n\nimport (\n    \"github.com/gofred-io/gofred/application\"\n    \"github.com/gofred-io/gofred/breakpoint\"\n    \"github.com/gofred-io/gofred/foundation/column\"\n    \"github.com/gofred-io/gofred/foundation/container\"\n    \"github.com/gofred-io/gofred/foundation/text\"\n    \"github.com/gofred-io/gofred/options/spacing\"\n)\n\nfunc main() {\n    app := createApp()\n    application.Run(app)\n}\n\nfunc createApp() application.BaseWidget {\n    return container.New(\n        column.New(\n            []application.BaseWidget{\n                text.New(\n                    \"My First gofred App\",\n                    text.FontSize(24),\n                    text.FontWeight(\"700\"),\n                ),\n                text.New(\n                    \"Welcome to gofred! This is your first application.\",\n                    text.FontSize(16),\n                    text.FontColor(\"#6B7280\"),\n                ),\n            },\n            column.Gap(16),\n        ),\n        container.Padding(breakpoint.All(spacing.All(32))),\n        container.BackgroundColor(\"#FFFFFF\"),\n    )\n}",
+	},
+	{
+		Href:    "/docs/first-app",
+		Page:    "Your First App",
+		Section: "Adding Interactivity",
+		Anchor:  "adding-interactivity",
+		Level:   2,
+		Text:    "Let's add a simple counter with buttons:",
+		Code:    "package main\n\nimport (\n    \"fmt\"\n\n    \"github.com/gofred-io/gofred/application\"\n    \"github.com/gofred-io/gofred/breakpoint\"\n    \"github.com/gofred-io/gofred/foundation/button\"\n    \"github.com/gofred-io/gofred/foundation/column\"\n    \"github.com/gofred-io/gofred/foundation/container\"\n    \"github.com/gofred-io/gofred/foundation/row\"\n    \"github.com/gofred-io/gofred/foundation/spacer\"\n    \"github.com/gofred-io/gofred/foundation/text\"\n    \"github.com/gofred-io/gofred/hooks\"\n    \"github.com/gofred-io/gofred/listenable\"\n    \"github.com/gofred-io/gofred/options/spacing\"\n)\n\nvar (\n    count, setCount = hooks.UseState(0)\n)\n\nfunc main() {\n    app := createApp()\n    application.Run(app)\n}\n\nfunc createApp() application.BaseWidget {\n    return container.New(\n        column.New(\n            []application.BaseWidget{\n                text.New(\n                    \"Counter App\",\n                    text.FontSize(24),\n                    text.FontWeight(\"700\"),\n                ),\n                listenable.Builder(count, func() application.BaseWidget {\n                    return text.New(\n                        fmt.Sprintf(\"Count: %d\", count.Value()),\n                        text.FontSize(18),\n                        text.FontColor(\"#2B799B\"),\n                        text.FontWeight(\"700\"),\n                    )\n                }),\n                spacer.New(spacer.Height(16)),\n                row.New(\n                    []application.BaseWidget{\n                        button.New(\n                            text.New(\"Decrease\", text.FontColor(\"#FFFFFF\")),\n                            button.BackgroundColor(\"#EF4444\"),\n                            button.OnClick(decreaseCount),\n                        ),\n                        spacer.New(spacer.Width(16)),\n                        button.New(\n                            text.New(\"Increase\", text.FontColor(\"#FFFFFF\")),\n                            button.BackgroundColor(\"#10B981\"),\n                            button.OnClick(increaseCount),\n                        ),\n                    },\n                    row.Gap(16),\n                ),\n            },\n            column.Gap(16),\n        ),\n        container.Padding(breakpoint.All(spacing.All(32))),\n        container.BackgroundColor(\"#FFFFFF\"),\n    )\n}\n\nfunc increaseCount(this application.BaseWidget, e application.Event) {\n    setCount(count.Value() + 1)\n}\n\nfunc decreaseCount(this application.BaseWidget, e application.Event) {\n    setCount(count.Value() - 1)\n}",
+	},
+	{
+		Href:    "/docs/first-app",
+		Page:    "Your First App",
+		Section: "Running Your App",
+		Anchor:  "running-your-app",
+		Level:   2,
+		Text:    "To run your application: Your app will compile to WebAssembly and run in your browser automatically!",
+		Code:    "go run server/server.go",
+	},
+	{
+		Href:    "/docs/first-app",
+		Page:    "Your First App",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you've built your first app, explore these topics: Project Structure Explore the project structure",
+	},
+	{
+		Href: "/docs/project-structure",
+		Page: "Project Structure",
+		Text: "Understand the recommended project structure for gofred applications.",
+	},
+	{
+		Href:    "/docs/project-structure",
+		Page:    "Project Structure",
+		Section: "Directory Structure",
+		Anchor:  "directory-structure",
+		Level:   2,
+		Text:    "A typical gofred project follows this structure:",
+		Code:    "my-gofred-app/\n├── app/                   # Application code\n│   ├── components/        # Reusable components\n│   │   └── code_block/    # Code block component\n│   ├── pages/             # Page components\n│   │   ├── 404/           # 404 error page\n│   │   ├── docs/          # Documentation pages\n│   │   └── home/          # Home page\n│   ├── theme/             # Theme and styling\n│   │   └── theme.go       # Theme configuration\n│   └── app.go             # Main application setup\n├── web/                   # Web assets\n│   ├── assets/            # Static assets\n│   │   ├── fonts/         # Font files\n│   │   ├── icons/         # Icon files\n│   │   └── images/        # Image files\n│   ├── index.css          # CSS styles\n│   └── index.html         # HTML template\n├── go.mod                 # Go module file\n├── go.sum                 # Go module checksums\n└── main.go                # Application entry point",
+	},
+	{
+		Href:    "/docs/project-structure",
+		Page:    "Project Structure",
+		Section: "Key Files",
+		Anchor:  "key-files",
+		Level:   2,
+		Text:    "Here's what each important file does: main.go The entry point of your application. Contains the main function and application initialization. app/app.go Main application setup, routing configuration, and global state management. app/pages/ Directory containing all page components. Each page should be in its own subdirectory (home, docs, 404, etc.). app/components/ Reusable UI components that can be used across multiple pages (code_block, etc.). app/theme/ Theme configuration, colors, typography, and global styling options. web/index.html HTML template file that serves as the base structure for the web application. web/index.css Main CSS file containing custom styles and overrides for the application. web/assets/ Directory containing static assets like images, fonts, and icons used throughout the application.",
+	},
+	{
+		Href:    "/docs/project-structure",
+		Page:    "Project Structure",
+		Section: "Best Practices",
+		Anchor:  "best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines for better organization: Keep pages in separate directories under app/pages/ Create reusable components in app/components/ Use consistent naming conventions (snake_case for files) Store HTML templates and CSS files in the web/ directory Organize static assets (images, fonts, icons) in web/assets/ Keep theme configuration centralized in app/theme/ Use meaningful directory and file names",
+	},
+	{
+		Href:    "/docs/project-structure",
+		Page:    "Project Structure",
+		Section: "Next Steps",
+		Anchor:  "next-steps",
+		Level:   2,
+		Text:    "Now that you have learned about the project structure, you can: Learn About Widgets Understand the building blocks of gofred applications Explore Layouts Learn how to arrange widgets with columns, rows, and grids Style Your App Make your application beautiful with colors, fonts, and spacing Learn State Management Handle dynamic data and user interactions properly",
+	},
+	{
+		Href: "/docs/widgets",
+		Page: "Widgets",
+		Text: "Foundation Widgets Learn about the core building blocks of gofred applications and how to use them effectively.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "What are Widgets?",
+		Anchor:  "what-are-widgets",
+		Level:   2,
+		Text:    "Widgets are the fundamental building blocks of gofred applications. They are composable UI components that can be combined to create complex user interfaces. Every element you see in a gofred app is a widget.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Layout Widgets",
+		Anchor:  "layout-widgets",
+		Level:   2,
+		Text:    "Layout widgets help you organize and position other widgets in your application.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Container",
+		Anchor:  "container",
+		Level:   3,
+		Text:    "A flexible container widget that can hold other widgets and apply styling properties like padding, background color, borders, and sizing.",
+		Code:    "container.New(\n    text.New(\"Hello, World!\"),\n    container.Padding(breakpoint.All(spacing.All(16))),\n    container.BackgroundColor(\"#F3F4F6\"),\n    container.BorderRadius(8),\n    container.BorderColor(\"#E5E7EB\"),\n    container.BorderWidth(spacing.All(1)),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Column",
+		Anchor:  "column",
+		Level:   3,
+		Text:    "Arranges child widgets vertically. Perfect for creating vertical layouts and forms.",
+		Code:    "column.New(\n    []application.BaseWidget{\n        text.New(\"First Item\"),\n        text.New(\"Second Item\"),\n        text.New(\"Third Item\"),\n    },\n    column.Gap(16),\n    column.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Row",
+		Anchor:  "row",
+		Level:   3,
+		Text:    "Arranges child widgets horizontally. Ideal for creating horizontal layouts and toolbars.",
+		Code:    "row.New(\n    []application.BaseWidget{\n        button.New(text.New(\"Cancel\")),\n        spacer.New(), // Pushes buttons apart\n        button.New(text.New(\"Submit\")),\n    },\n    row.Gap(12),\n    row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Grid",
+		Anchor:  "grid",
+		Level:   3,
+		Text:    "Creates a responsive grid layout for organizing widgets in rows and columns.",
+		Code:    "grid.New(\n    []application.BaseWidget{\n        cardWidget(\"Card 1\"),\n        cardWidget(\"Card 2\"),\n        cardWidget(\"Card 3\"),\n        cardWidget(\"Card 4\"),\n    },\n    grid.ColumnCount(\n        breakpoint.XS(1),\n        breakpoint.MD(2),\n        breakpoint.LG(4),\n    ),\n    grid.ColumnGap(16),\n    grid.RowGap(16),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Center",
+		Anchor:  "center",
+		Level:   3,
+		Text:    "Centers its child widget both horizontally and vertically.",
+		Code:    "center.New(\n    text.New(\n        \"Centered Content\",\n        text.FontSize(24),\n        text.FontWeight(\"700\"),\n    ),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Spacer",
+		Anchor:  "spacer",
+		Level:   3,
+		Text:    "Creates flexible or fixed spacing between widgets.",
+		Code:    "// Fixed spacing\nspacer.New(spacer.Height(24))\nspacer.New(spacer.Width(16))\n\n// Flexible spacing (takes available space)\nspacer.New()",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Content Widgets",
+		Anchor:  "content-widgets",
+		Level:   2,
+		Text:    "Content widgets display information and media to users.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Text",
+		Anchor:  "text",
+		Level:   3,
+		Text:    "Displays text with customizable styling options like font size, color, weight, and alignment.",
+		Code:    "text.New(\n    \"Hello, gofred!\",\n    text.FontSize(18),\n        text.FontWeight(\"700\"),\n    text.TextAlign(theme.TextAlignTypeCenter),\n    text.LineHeight(1.5),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Icon",
+		Anchor:  "icon",
+		Level:   3,
+		Text:    "Displays scalable vector icons from the built-in icon library.",
+		Code:    "icon.New(\n    icondata.Home,\n    icon.Width(breakpoint.All(24)),\n    icon.Height(breakpoint.All(24)),\n    icon.Fill(\"#2B799B\"),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Image",
+		Anchor:  "image",
+		Level:   3,
+		Text:    "Displays images with support for various formats and responsive sizing.",
+		Code:    "image.New(\n    image.Src(\"/assets/logo.png\"),\n    image.Alt(\"Company Logo\"),\n    image.Width(breakpoint.All(200)),\n    image.Height(breakpoint.All(100)),\n    image.ObjectFit(theme.ObjectFitTypeCover),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Interactive Widgets",
+		Anchor:  "interactive-widgets",
+		Level:   2,
+		Text:    "Interactive widgets respond to user input and enable user interactions.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Button",
+		Anchor:  "button",
+		Level:   3,
+		Text:    "A clickable button widget that can trigger actions and navigate between screens.",
+		Code:    "button.New(\n    text.New(\"Click Me\", text.FontColor(\"#FFFFFF\")),\n    button.BackgroundColor(\"#2B799B\"),\n    button.BorderRadius(8),\n    button.Padding(breakpoint.All(spacing.Symmetric(16, 12))),\n    button.OnClick(handleButtonClick),\n)\n\nfunc handleButtonClick(this application.BaseWidget, e application.Event) {\n    // Handle button click\n    fmt.Println(\"Button clicked!\")\n}",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Icon Button",
+		Anchor:  "icon-button",
+		Level:   3,
+		Text:    "A button that contains only an icon, perfect for toolbars and action menus.",
+		Code:    "iconbutton.New(\n    icondata.Settings,\n    iconbutton.IconWidth(breakpoint.All(20)),\n    iconbutton.IconHeight(breakpoint.All(20)),\n    iconbutton.IconFill(\"#6B7280\"),\n    iconbutton.BackgroundColor(\"#F9FAFB\"),\n    iconbutton.BorderRadius(6),\n    iconbutton.OnClick(openSettings),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Link",
+		Anchor:  "link",
+		Level:   3,
+		Text:    "Creates navigational links that can route to different pages or external URLs.",
+		Code:    "link.New(\n    text.New(\n        \"Go to Documentation\",\n        text.FontColor(\"#2B799B\"),\n        text.TextDecoration(theme.TextDecorationTypeUnderline),\n    ),\n    link.Href(\"/docs\"),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Navigation Widgets",
+		Anchor:  "navigation-widgets",
+		Level:   2,
+		Text:    "Navigation widgets help users move through your application.",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Drawer",
+		Anchor:  "drawer",
+		Level:   3,
+		Text:    "A slide-out panel that can contain navigation menus or additional content.",
+		Code:    "drawer.New(\n    drawerContent(), // Your drawer content\n    drawer.Width(breakpoint.All(300)),\n    drawer.BackgroundColor(\"#FFFFFF\"),\n    drawer.BorderColor(\"#E5E7EB\"),\n    drawer.BorderWidth(0, 1, 0, 0),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Router",
+		Anchor:  "router",
+		Level:   3,
+		Text:    "Manages navigation and routing in your application.",
+		Code:    "router.New(\n    router.Routes([]router.Route{\n        {Path: \"/\", Handler: homePage},\n        {Path: \"/about\", Handler: aboutPage},\n        {Path: \"/contact\", Handler: contactPage},\n    }),\n)",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Widget Composition",
+		Anchor:  "widget-composition",
+		Level:   2,
+		Text:    "Widgets can be composed together to create complex UI components. Here's an example of building a card component:",
+		Code:    "func cardWidget(title, content string) application.BaseWidget {\n    return container.New(\n        column.New(\n            []application.BaseWidget{\n                text.New(\n                    title,\n                    text.FontSize(18),\n                    text.FontWeight(\"700\"),\n                                    ),\n                spacer.New(spacer.Height(8)),\n                text.New(\n                    content,\n                    text.FontSize(14),\n                    text.FontColor(\"#6B7280\"),\n                    text.LineHeight(1.5),\n                ),\n                spacer.New(spacer.Height(16)),\n                row.New(\n                    []application.BaseWidget{\n                        spacer.New(),\n                        button.New(\n                            text.New(\"Learn More\", text.FontColor(\"#2B799B\")),\n                            button.BackgroundColor(\"transparent\"),\n                            button.BorderColor(\"#2B799B\"),\n                            button.BorderWidth(1, 1, 1, 1),\n                        ),\n                    },\n                ),\n            },\n            column.Gap(0),\n        ),\n        container.Padding(breakpoint.All(spacing.All(16))),\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        container.BorderStyle(theme.BorderStyleTypeSolid),\n    )\n}",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "Best Practices",
+		Anchor:  "best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines when working with widgets: Use semantic widget names that clearly describe their purpose Keep widget composition simple and avoid deep nesting when possible Use spacer widgets for consistent spacing instead of margins Leverage breakpoints for responsive design across different screen sizes Create reusable widget functions for components used multiple times Use appropriate layout widgets (column, row, grid) based on your design needs Consider accessibility when choosing colors and font sizes Test your widgets across different screen sizes and browsers",
+	},
+	{
+		Href:    "/docs/widgets",
+		Page:    "Widgets",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you understand widgets, explore these related topics: Layouts Learn advanced layout techniques and responsive design Styling Discover how to style your widgets with colors, fonts, and spacing State Management Handle dynamic data and user interactions with hooks Events Learn how to handle user interactions and events",
+	},
+	{
+		Href: "/docs/layouts",
+		Page: "Layouts",
+		Text: "Layouts & Responsive Design Master the art of creating beautiful, responsive layouts that work perfectly across all devices and screen sizes.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Layout Fundamentals",
+		Anchor:  "layout-fundamentals",
+		Level:   2,
+		Text:    "Layouts in gofred are built using a flexbox-inspired system that makes it easy to create responsive, flexible designs. The main layout widgets work together to help you arrange content exactly how you want it.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Column Layouts",
+		Anchor:  "column-layouts",
+		Level:   2,
+		Text:    "Columns arrange widgets vertically, perfect for forms, lists, and content that flows from top to bottom.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Basic Column",
+		Anchor:  "basic-column",
+		Level:   3,
+		Text:    "The foundation of vertical layouts.",
+		Code:    "column.New(\n    []application.BaseWidget{\n        text.New(\"Header\", text.FontSize(24), text.FontWeight(\"700\")),\n        text.New(\"Subtitle\", text.FontSize(16), text.FontColor(\"#6B7280\")),\n        text.New(\"Content goes here...\"),\n        button.New(text.New(\"Action Button\")),\n    },\n    column.Gap(16), // Space between items\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Column Alignment",
+		Anchor:  "column-alignment",
+		Level:   3,
+		Text:    "Control how items are aligned within the column.",
+		Code:    "// Center-aligned column\ncolumn.New(\n    []application.BaseWidget{\n        text.New(\"Centered Title\"),\n        text.New(\"Centered content\"),\n        button.New(text.New(\"Centered Button\")),\n    },\n    column.Gap(12),\n    column.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n)\n\n// Right-aligned column\ncolumn.New(\n    items,\n    column.CrossAxisAlignment(theme.AxisAlignmentTypeEnd),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Flexible Columns",
+		Anchor:  "flexible-columns",
+		Level:   3,
+		Text:    "Make columns expand to fill available space.",
+		Code:    "column.New(\n    []application.BaseWidget{\n        header(),\n        column.New(\n            contentItems,\n            column.Flex(1), // This column takes remaining space\n        ),\n        footer(),\n    },\n    column.Gap(0),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Row Layouts",
+		Anchor:  "row-layouts",
+		Level:   2,
+		Text:    "Rows arrange widgets horizontally, ideal for navigation bars, button groups, and side-by-side content.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Basic Row",
+		Anchor:  "basic-row",
+		Level:   3,
+		Text:    "The foundation of horizontal layouts.",
+		Code:    "row.New(\n    []application.BaseWidget{\n        icon.New(icondata.User, icon.Width(breakpoint.All(20))),\n        text.New(\"John Doe\", text.FontWeight(\"500\")),\n        spacer.New(), // Pushes next items to the right\n        text.New(\"Online\", text.FontColor(\"#10B981\")),\n        button.New(text.New(\"Contact\")),\n    },\n    row.Gap(12),\n    row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Row Alignment",
+		Anchor:  "row-alignment",
+		Level:   3,
+		Text:    "Control vertical alignment of items in a row.",
+		Code:    "// Top-aligned row\nrow.New(\n    []application.BaseWidget{\n        largeImage(),\n        column.New(smallTextItems),\n    },\n    row.CrossAxisAlignment(theme.AxisAlignmentTypeStart),\n)\n\n// Bottom-aligned row\nrow.New(\n    items,\n    row.CrossAxisAlignment(theme.AxisAlignmentTypeEnd),\n)\n\n// Stretch items to same height\nrow.New(\n    items,\n    row.CrossAxisAlignment(theme.AxisAlignmentTypeStretch),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Flexible Rows",
+		Anchor:  "flexible-rows",
+		Level:   3,
+		Text:    "Distribute space between row items.",
+		Code:    "row.New(\n    []application.BaseWidget{\n        container.New(\n            text.New(\"Left Panel\"),\n            container.Flex(1), // Takes 1/3 of space\n        ),\n        container.New(\n            text.New(\"Main Content\"),\n            container.Flex(2), // Takes 2/3 of space\n        ),\n        container.New(\n            text.New(\"Right Panel\"),\n            container.Width(breakpoint.All(200)), // Fixed width\n        ),\n    },\n    row.Gap(16),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Grid Layouts",
+		Anchor:  "grid-layouts",
+		Level:   2,
+		Text:    "Grids create two-dimensional layouts perfect for cards, galleries, and dashboard-style interfaces.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Responsive Grid",
+		Anchor:  "responsive-grid",
+		Level:   3,
+		Text:    "Create grids that adapt to screen size.",
+		Code:    "grid.New(\n    []application.BaseWidget{\n        productCard(\"Product 1\"),\n        productCard(\"Product 2\"),\n        productCard(\"Product 3\"),\n        productCard(\"Product 4\"),\n        productCard(\"Product 5\"),\n        productCard(\"Product 6\"),\n    },\n    grid.ColumnCount(\n        breakpoint.XS(1),  // 1 column on extra small screens\n        breakpoint.SM(1),  // 1 column on small screens\n        breakpoint.MD(2),  // 2 columns on medium screens\n        breakpoint.LG(3),  // 3 columns on large screens\n        breakpoint.XL(4),  // 4 columns on extra large screens\n    ),\n    grid.ColumnGap(16),\n    grid.RowGap(16),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Fixed Grid",
+		Anchor:  "fixed-grid",
+		Level:   3,
+		Text:    "Create grids with consistent column counts.",
+		Code:    "// 3-column grid for desktop dashboard\ngrid.New(\n    []application.BaseWidget{\n        dashboardCard(\"Sales\", \"$12,345\"),\n        dashboardCard(\"Orders\", \"156\"),\n        dashboardCard(\"Users\", \"1,234\"),\n        dashboardCard(\"Revenue\", \"$45,678\"),\n        dashboardCard(\"Growth\", \"+12%\"),\n        dashboardCard(\"Conversion\", \"3.2%\"),\n    },\n    grid.ColumnCount(breakpoint.All(3)),\n    grid.ColumnGap(24),\n    grid.RowGap(24),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Container Layouts",
+		Anchor:  "container-layouts",
+		Level:   2,
+		Text:    "Containers provide structure, spacing, and styling to your layouts.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Basic Container",
+		Anchor:  "basic-container",
+		Level:   3,
+		Text:    "Wrap content with padding and styling.",
+		Code:    "container.New(\n    column.New(\n        []application.BaseWidget{\n            text.New(\"Card Title\", text.FontWeight(\"700\")),\n            text.New(\"Card content goes here...\"),\n        },\n        column.Gap(8),\n    ),\n    container.Padding(breakpoint.All(spacing.All(16))),\n    container.BackgroundColor(\"#FFFFFF\"),\n    container.BorderRadius(8),\n    container.BorderColor(\"#E5E7EB\"),\n    container.BorderWidth(spacing.All(1)),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Responsive Container",
+		Anchor:  "responsive-container",
+		Level:   3,
+		Text:    "Containers that adapt to screen size.",
+		Code:    "container.New(\n    content,\n    container.Padding(\n        breakpoint.XS(spacing.All(8)),\n        breakpoint.SM(spacing.All(16)),\n        breakpoint.MD(spacing.All(24)),\n        breakpoint.LG(spacing.All(32)),\n    ),\n    container.MaxWidth(\n        breakpoint.SM(breakpoint.All(640)),\n        breakpoint.MD(breakpoint.All(768)),\n        breakpoint.LG(breakpoint.All(1024)),\n        breakpoint.XL(breakpoint.All(1280)),\n    ),\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Responsive Design",
+		Anchor:  "responsive-design",
+		Level:   2,
+		Text:    "gofred's breakpoint system makes it easy to create layouts that work on any device.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Breakpoint System",
+		Anchor:  "breakpoint-system",
+		Level:   3,
+		Text:    "Define different behaviors for different screen sizes.",
+		Code:    "// Available breakpoints:\n// breakpoint.XS   - Extra small (< 640px)\n// breakpoint.SM   - Small (≥ 640px)\n// breakpoint.MD   - Medium (≥ 768px)\n// breakpoint.LG   - Large (≥ 1024px)\n// breakpoint.XL   - Extra large (≥ 1280px)\n// breakpoint.XXL  - 2X large (≥ 1536px)\n\n// Example: Different layouts for different screen sizes\nfunc responsiveLayout() application.BaseWidget {\n    return container.New(\n        grid.New(\n            contentCards(),\n            grid.ColumnCount(\n                breakpoint.XS(1),  // Stack on mobile\n                breakpoint.MD(2),  // Side-by-side on tablet\n                breakpoint.LG(4),  // Four columns on desktop\n            ),\n            grid.ColumnGap(16),\n        ),\n        container.Padding(\n            breakpoint.XS(spacing.All(16)),\n            breakpoint.LG(spacing.All(32)),\n        ),\n    )\n}",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Mobile-First Design",
+		Anchor:  "mobile-first-design",
+		Level:   3,
+		Text:    "Start with mobile layouts and enhance for larger screens.",
+		Code:    "// Mobile-first navigation\nfunc navigationBar() application.BaseWidget {\n    return container.New(\n        row.New(\n            []application.BaseWidget{\n                // Logo\n                logo(),\n                spacer.New(),\n                // Mobile: Hamburger menu, Desktop: Full navigation\n                container.New(\n                    row.New(\n                        []application.BaseWidget{\n                            // Shown on mobile, hidden on desktop\n                            container.New(\n                                hamburgerMenu(),\n                                container.Visible(\n                                    breakpoint.XS(true),\n                                    breakpoint.MD(false),\n                                ),\n                            ),\n                            // Hidden on mobile, shown on desktop\n                            container.New(\n                                row.New(navItems(), row.Gap(24)),\n                                container.Visible(\n                                    breakpoint.XS(false),\n                                    breakpoint.MD(true),\n                                ),\n                            ),\n                        },\n                    ),\n                ),\n            },\n            row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n        ),\n        container.Padding(breakpoint.All(spacing.All(16))),\n    )\n}",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Common Layout Patterns",
+		Anchor:  "common-layout-patterns",
+		Level:   2,
+		Text:    "Learn proven patterns for building common UI layouts.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Header-Content-Footer",
+		Anchor:  "header-content-footer",
+		Level:   3,
+		Text:    "The classic three-section layout.",
+		Code:    "func appLayout() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Header\n            container.New(\n                navigationBar(),\n                container.Height(breakpoint.All(64)),\n                container.BackgroundColor(\"#FFFFFF\"),\n                container.BorderColor(\"#E5E7EB\"),\n                container.BorderWidth(0, 0, 1, 0),\n            ),\n            // Main Content\n            container.New(\n                mainContent(),\n                container.Flex(1), // Takes remaining height\n                container.BackgroundColor(\"#F9FAFB\"),\n            ),\n            // Footer\n            container.New(\n                footer(),\n                container.BackgroundColor(\"#1F2937\"),\n            ),\n        },\n        column.Flex(1), // Fills the height of the page\n    )\n}",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Sidebar Layout",
+		Anchor:  "sidebar-layout",
+		Level:   3,
+		Text:    "Content with a side navigation panel.",
+		Code:    "func dashboardLayout() application.BaseWidget {\n    return row.New(\n        []application.BaseWidget{\n            // Sidebar\n            container.New(\n                sidebar(),\n                container.Width(\n                    breakpoint.MD(breakpoint.All(240)),\n                    breakpoint.LG(breakpoint.All(280)),\n                ),\n                container.Visible(\n                    breakpoint.XS(false),\n                    breakpoint.MD(true),\n                ),\n                container.BackgroundColor(\"#FFFFFF\"),\n                container.BorderColor(\"#E5E7EB\"),\n                container.BorderWidth(0, 1, 0, 0),\n            ),\n            // Main Content\n            container.New(\n                dashboardContent(),\n                container.Flex(1),\n                container.Padding(breakpoint.All(spacing.All(24))),\n            ),\n        },\n        row.Flex(1), // Fills the height of the page\n    )\n}",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Card Layout",
+		Anchor:  "card-layout",
+		Level:   3,
+		Text:    "Reusable card components for content display.",
+		Code:    "func cardLayout(title, content string, actions []application.BaseWidget) application.BaseWidget {\n    return container.New(\n        column.New(\n            []application.BaseWidget{\n                // Card Header\n                row.New(\n                    []application.BaseWidget{\n                        text.New(\n                            title,\n                            text.FontSize(18),\n                            text.FontWeight(\"700\"),\n                                                    ),\n                        spacer.New(),\n                        // Optional header actions\n                        row.New(actions, row.Gap(8)),\n                    },\n                    row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n                ),\n                // Card Content\n                container.New(\n                    text.New(\n                        content,\n                        text.FontSize(14),\n                        text.FontColor(\"#6B7280\"),\n                        text.LineHeight(1.5),\n                    ),\n                    container.Padding(breakpoint.All(spacing.Symmetric(0, 16))),\n                ),\n                // Card Footer\n                row.New(\n                    []application.BaseWidget{\n                        spacer.New(),\n                        button.New(\n                            text.New(\"Learn More\", text.FontColor(\"#2B799B\")),\n                            button.BackgroundColor(\"transparent\"),\n                        ),\n                    },\n                ),\n            },\n            column.Gap(16),\n        ),\n        container.Padding(breakpoint.All(spacing.All(16))),\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        container.BorderStyle(theme.BorderStyleTypeSolid),\n    )\n}",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Spacing & Alignment",
+		Anchor:  "spacing-alignment",
+		Level:   2,
+		Text:    "Master the art of spacing and alignment for polished layouts.",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Consistent Spacing",
+		Anchor:  "consistent-spacing",
+		Level:   3,
+		Text:    "Use a consistent spacing scale throughout your app.",
+		Code:    "// Define your spacing scale\nconst (\n    SpaceXS  = 4\n    SpaceSM  = 8\n    SpaceMD  = 16\n    SpaceLG  = 24\n    SpaceXL  = 32\n    SpaceXXL = 48\n)\n\n// Use spacing consistently\ncolumn.New(\n    []application.BaseWidget{\n        sectionHeader(),\n        spacer.New(spacer.Height(SpaceLG)),\n        sectionContent(),\n        spacer.New(spacer.Height(SpaceXL)),\n        nextSection(),\n    },\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Spacer Widget",
+		Anchor:  "spacer-widget",
+		Level:   3,
+		Text:    "Use spacers for flexible and fixed spacing.",
+		Code:    "// Fixed spacing\nspacer.New(spacer.Height(24))\nspacer.New(spacer.Width(16))\n\n// Flexible spacing - takes all available space\nspacer.New()\n\n// Example: Pushing items apart\nrow.New(\n    []application.BaseWidget{\n        leftContent(),\n        spacer.New(), // Pushes rightContent to the far right\n        rightContent(),\n    },\n)",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "Layout Best Practices",
+		Anchor:  "layout-best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines for creating effective layouts. Start with mobile-first design and progressively enhance for larger screens Use consistent spacing throughout your application with a defined scale Choose the right layout widget for your content (column for vertical, row for horizontal, grid for two-dimensional) Leverage flexbox properties (Flex, CrossAxisAlignment) for flexible layouts Use containers to group related content and provide consistent styling Test your layouts across different screen sizes and orientations Keep layout hierarchy simple - avoid deeply nested layout widgets when possible Use spacer widgets instead of manual margins for better layout control Consider content flow and reading patterns when designing layouts Make interactive elements easily accessible with proper spacing and sizing",
+	},
+	{
+		Href:    "/docs/layouts",
+		Page:    "Layouts",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you understand layouts, explore these related topics: Styling Learn how to style your layouts with colors, fonts, and visual effects State Management Handle dynamic content and user interactions in your layouts Events Learn how to handle user interactions and events",
+	},
+	{
+		Href: "/docs/styling",
+		Page: "Styling",
+		Text: "Styling & Visual Design Master the art of styling gofred widgets with colors, typography, spacing, borders, and responsive design principles.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Styling Philosophy",
+		Anchor:  "styling-philosophy",
+		Level:   2,
+		Text:    "gofred follows a design system approach where styling is applied directly to widgets using properties and options. This ensures consistent, predictable, and maintainable styling throughout your application.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Color System",
+		Anchor:  "color-system",
+		Level:   2,
+		Text:    "Colors in gofred can be applied to text, backgrounds, borders, and icons using hex values, RGB, or named colors.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Text Colors",
+		Anchor:  "text-colors",
+		Level:   3,
+		Text:    "Apply colors to text for emphasis, hierarchy, and branding.",
+		Code:    "// Basic text colors\ntext.New(\"Primary Text\", text.FontColor(\"#1F2937\"))    // Dark gray\ntext.New(\"Secondary Text\", text.FontColor(\"#6B7280\"))  // Medium gray\ntext.New(\"Muted Text\", text.FontColor(\"#9CA3AF\"))      // Light gray\n\n// Semantic colors\ntext.New(\"Success Message\", text.FontColor(\"#10B981\")) // Green\ntext.New(\"Error Message\", text.FontColor(\"#EF4444\"))   // Red\ntext.New(\"Warning Message\", text.FontColor(\"#F59E0B\")) // Orange\ntext.New(\"Info Message\", text.FontColor(\"#3B82F6\"))    // Blue\n\n// Brand colors\ntext.New(\"Brand Text\", text.FontColor(\"#2B799B\"))      // Primary brand\ntext.New(\"Accent Text\", text.FontColor(\"#7C3AED\"))     // Purple accent",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Background Colors",
+		Anchor:  "background-colors",
+		Level:   3,
+		Text:    "Set background colors for containers, buttons, and other widgets.",
+		Code:    "// Container backgrounds\ncontainer.New(\n    content,\n    container.BackgroundColor(\"#FFFFFF\"),    // White\n    container.BackgroundColor(\"#F9FAFB\"),    // Light gray\n    container.BackgroundColor(\"#1F2937\"),    // Dark\n)\n\n// Button backgrounds\nbutton.New(\n    text.New(\"Primary\", text.FontColor(\"#FFFFFF\")),\n    button.BackgroundColor(\"#2B799B\"),       // Primary\n)\nbutton.New(\n    text.New(\"Success\", text.FontColor(\"#FFFFFF\")),\n    button.BackgroundColor(\"#10B981\"),       // Success\n)\nbutton.New(\n    text.New(\"Danger\", text.FontColor(\"#FFFFFF\")),\n    button.BackgroundColor(\"#EF4444\"),       // Danger\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Icon Colors",
+		Anchor:  "icon-colors",
+		Level:   3,
+		Text:    "Colorize icons to match your design system.",
+		Code:    "// Icon colors with fill\nicon.New(\n    icondata.Heart,\n    icon.Fill(\"#EF4444\"),              // Red heart\n    icon.Width(breakpoint.All(24)),\n    icon.Height(breakpoint.All(24)),\n)\n\n// Status icons\nicon.New(icondata.Check, icon.Fill(\"#10B981\"))    // Green check\nicon.New(icondata.X, icon.Fill(\"#EF4444\"))        // Red X\nicon.New(icondata.AlertTriangle, icon.Fill(\"#F59E0B\")) // Orange warning",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Typography",
+		Anchor:  "typography",
+		Level:   2,
+		Text:    "Typography creates hierarchy, improves readability, and establishes your brand voice.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Font Sizes",
+		Anchor:  "font-sizes",
+		Level:   3,
+		Text:    "Use a consistent scale for font sizes throughout your application.",
+		Code:    "// Heading sizes\ntext.New(\"Large Heading\", text.FontSize(48))      // 48px\ntext.New(\"Page Title\", text.FontSize(32))         // 32px\ntext.New(\"Section Title\", text.FontSize(24))      // 24px\ntext.New(\"Subsection\", text.FontSize(20))         // 20px\ntext.New(\"Heading\", text.FontSize(18))            // 18px\n\n// Body text sizes\ntext.New(\"Large Body\", text.FontSize(16))         // 16px\ntext.New(\"Body Text\", text.FontSize(14))          // 14px\ntext.New(\"Small Text\", text.FontSize(12))         // 12px\ntext.New(\"Caption\", text.FontSize(10))            // 10px",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Font Weights",
+		Anchor:  "font-weights",
+		Level:   3,
+		Text:    "Control text emphasis with different font weights.",
+		Code:    "text.New(\"Thin Text\", text.FontWeight(\"100\"))\ntext.New(\"Light Text\", text.FontWeight(\"300\"))\ntext.New(\"Normal Text\", text.FontWeight(\"400\"))     // Default\ntext.New(\"Medium Text\", text.FontWeight(\"500\"))\ntext.New(\"Semibold Text\", text.FontWeight(\"700\"))\ntext.New(\"Bold Text\", text.FontWeight(\"700\"))\ntext.New(\"Extra Bold\", text.FontWeight(\"800\"))\ntext.New(\"Black Text\", text.FontWeight(\"900\"))",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Text Alignment & Layout",
+		Anchor:  "text-alignment-layout",
+		Level:   3,
+		Text:    "Control text alignment and layout properties.",
+		Code:    "// Text alignment\ntext.New(\"Left Aligned\", text.TextAlign(theme.TextAlignTypeLeft))\ntext.New(\"Center Aligned\", text.TextAlign(theme.TextAlignTypeCenter))\ntext.New(\"Right Aligned\", text.TextAlign(theme.TextAlignTypeRight))\ntext.New(\"Justified\", text.TextAlign(theme.TextAlignTypeJustify))\n\n// Line height for readability\ntext.New(\n    \"Lorem ipsum dolor sit amet, consectetur adipiscing elit.\",\n    text.LineHeight(1.2),    // Tight\n    text.LineHeight(1.5),    // Normal (recommended for body text)\n    text.LineHeight(1.8),    // Loose\n)\n\n// Text decorations\ntext.New(\"Underlined Link\", text.TextDecoration(theme.TextDecorationTypeUnderline))\ntext.New(\"Strike Through\", text.TextDecoration(theme.TextDecorationTypeLineThrough))",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Spacing System",
+		Anchor:  "spacing-system",
+		Level:   2,
+		Text:    "Consistent spacing creates visual rhythm and improves user experience.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Padding",
+		Anchor:  "padding",
+		Level:   3,
+		Text:    "Add internal spacing to widgets with padding.",
+		Code:    "// Uniform padding\ncontainer.New(\n    content,\n    container.Padding(breakpoint.All(spacing.All(16))),    // 16px all sides\n    container.Padding(breakpoint.All(spacing.All(24))),    // 24px all sides\n)\n\n// Symmetric padding (horizontal, vertical)\ncontainer.New(\n    content,\n    container.Padding(breakpoint.All(spacing.Symmetric(24, 16))), // 24px h, 16px v\n)\n\n// Individual sides\ncontainer.New(\n    content,\n    container.Padding(breakpoint.All(spacing.Only(\n        16,  // top\n        24,  // right\n        16,  // bottom\n        12,  // left\n    ))),\n)\n\n// Responsive padding\ncontainer.New(\n    content,\n    container.Padding(\n        breakpoint.XS(spacing.All(8)),   // Small on mobile\n        breakpoint.MD(spacing.All(16)),  // Medium on tablet\n        breakpoint.LG(spacing.All(24)),  // Large on desktop\n    ),\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Margins & Gaps",
+		Anchor:  "margins-gaps",
+		Level:   3,
+		Text:    "Control spacing between widgets.",
+		Code:    "// Column gaps\ncolumn.New(\n    []application.BaseWidget{item1, item2, item3},\n    column.Gap(8),     // Small gap\n    column.Gap(16),    // Medium gap\n    column.Gap(24),    // Large gap\n)\n\n// Row gaps\nrow.New(\n    []application.BaseWidget{item1, item2, item3},\n    row.Gap(12),       // Consistent spacing\n)\n\n// Grid gaps\ngrid.New(\n    items,\n    grid.ColumnGap(16),  // Horizontal spacing\n    grid.RowGap(20),     // Vertical spacing\n)\n\n// Manual spacing with spacers\ncolumn.New(\n    []application.BaseWidget{\n        section1(),\n        spacer.New(spacer.Height(32)),  // Fixed spacing\n        section2(),\n        spacer.New(),                   // Flexible spacing\n        footer(),\n    },\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Borders & Visual Effects",
+		Anchor:  "borders-visual-effects",
+		Level:   2,
+		Text:    "Add definition and depth to your interfaces with borders and visual effects.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Border Styles",
+		Anchor:  "border-styles",
+		Level:   3,
+		Text:    "Define borders for containers and interactive elements.",
+		Code:    "// Basic border\ncontainer.New(\n    content,\n    container.BorderColor(\"#E5E7EB\"),\n    container.BorderWidth(spacing.All(1)),      // top, right, bottom, left\n    container.BorderStyle(theme.BorderStyleTypeSolid),\n)\n\n// Colored borders\ncontainer.New(\n    content,\n    container.BorderColor(\"#2B799B\"),       // Blue border\n    container.BorderWidth(2, 2, 2, 2),      // Thicker border\n)\n\n// Partial borders\ncontainer.New(\n    content,\n    container.BorderColor(\"#E5E7EB\"),\n    container.BorderWidth(0, 0, 1, 0),      // Bottom border only\n)\n\n// Focus states for interactive elements\nbutton.New(\n    text.New(\"Click me\"),\n    button.BorderColor(\"#2B799B\"),\n    button.BorderWidth(2, 2, 2, 2),\n    button.BorderStyle(theme.BorderStyleTypeSolid),\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Border Radius",
+		Anchor:  "border-radius",
+		Level:   3,
+		Text:    "Round corners for modern, friendly interfaces.",
+		Code:    "// Border radius values\ncontainer.New(content, container.BorderRadius(4))   // Subtle rounding\ncontainer.New(content, container.BorderRadius(8))   // Standard rounding\ncontainer.New(content, container.BorderRadius(12))  // More rounded\ncontainer.New(content, container.BorderRadius(16))  // Rounded\ncontainer.New(content, container.BorderRadius(24))  // Very rounded\n\n// Circular elements\ncontainer.New(\n    icon.New(icondata.User),\n    container.BorderRadius(24),          // Full circle\n    container.Width(breakpoint.All(48)),\n    container.Height(breakpoint.All(48)),\n)\n\n// Pill-shaped buttons\nbutton.New(\n    text.New(\"Pill Button\"),\n    button.BorderRadius(9999),\n    button.Padding(breakpoint.All(spacing.Symmetric(20, 12))),\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Responsive Styling",
+		Anchor:  "responsive-styling",
+		Level:   2,
+		Text:    "Adapt your styling to different screen sizes and devices.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Breakpoint-Based Styling",
+		Anchor:  "breakpoint-based-styling",
+		Level:   3,
+		Text:    "Apply different styles at different screen sizes.",
+		Code:    "// Responsive font sizes\ntext.New(\n    \"Responsive Heading\",\n    text.FontSize(\n        breakpoint.XS(24),    // Small on mobile\n        breakpoint.MD(32),    // Medium on tablet\n        breakpoint.LG(48),    // Large on desktop\n    ),\n)\n\n// Responsive padding\ncontainer.New(\n    content,\n    container.Padding(\n        breakpoint.XS(spacing.All(8)),\n        breakpoint.SM(spacing.All(16)),\n        breakpoint.MD(spacing.All(24)),\n        breakpoint.LG(spacing.All(32)),\n    ),\n)\n\n// Responsive visibility\ncontainer.New(\n    mobileOnlyContent,\n    container.Visible(\n        breakpoint.XS(true),\n        breakpoint.MD(false),\n    ),\n)\n\n// Responsive colors (for themes)\ntext.New(\n    \"Theme-aware text\",\n    text.FontColor(\n        breakpoint.All(\"#1F2937\"),    // Dark text for light theme\n        // Could add dark theme support here\n    ),\n)",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Mobile-First Styling",
+		Anchor:  "mobile-first-styling",
+		Level:   3,
+		Text:    "Start with mobile styles and enhance for larger screens.",
+		Code:    "// Mobile-first approach\nfunc responsiveCard() application.BaseWidget {\n    return container.New(\n        cardContent(),\n        // Base styles (mobile)\n        container.Padding(breakpoint.All(spacing.All(12))),\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        \n        // Enhanced styles for larger screens\n        container.Padding(\n            breakpoint.MD(spacing.All(16)),\n            breakpoint.LG(spacing.All(24)),\n        ),\n        container.BorderRadius(\n            breakpoint.MD(12),\n        ),\n    )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Component Styling Patterns",
+		Anchor:  "component-styling-patterns",
+		Level:   2,
+		Text:    "Learn common patterns for styling different types of components.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Button Styles",
+		Anchor:  "button-styles",
+		Level:   3,
+		Text:    "Create consistent button styling across your application.",
+		Code:    "// Primary button\nfunc primaryButton(label string) application.BaseWidget {\n    return button.New(\n        text.New(label, text.FontColor(\"#FFFFFF\"), text.FontWeight(\"500\")),\n        button.BackgroundColor(\"#2B799B\"),\n        button.BorderRadius(8),\n        button.Padding(breakpoint.All(spacing.Symmetric(16, 12))),\n    )\n}\n\n// Secondary button\nfunc secondaryButton(label string) application.BaseWidget {\n    return button.New(\n        text.New(label, text.FontColor(\"#2B799B\"), text.FontWeight(\"500\")),\n        button.BackgroundColor(\"transparent\"),\n        button.BorderColor(\"#2B799B\"),\n        button.BorderWidth(1, 1, 1, 1),\n        button.BorderRadius(8),\n        button.Padding(breakpoint.All(spacing.Symmetric(16, 12))),\n    )\n}\n\n// Danger button\nfunc dangerButton(label string) application.BaseWidget {\n    return button.New(\n        text.New(label, text.FontColor(\"#FFFFFF\"), text.FontWeight(\"500\")),\n        button.BackgroundColor(\"#EF4444\"),\n        button.BorderRadius(8),\n        button.Padding(breakpoint.All(spacing.Symmetric(16, 12))),\n    )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Card Styles",
+		Anchor:  "card-styles",
+		Level:   3,
+		Text:    "Design consistent card components.",
+		Code:    "// Basic card\nfunc basicCard(content application.BaseWidget) application.BaseWidget {\n    return container.New(\n        content,\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        container.Padding(breakpoint.All(spacing.All(16))),\n    )\n}\n\n// Elevated card (with shadow effect using border)\nfunc elevatedCard(content application.BaseWidget) application.BaseWidget {\n    return container.New(\n        content,\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(12),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        container.Padding(breakpoint.All(spacing.All(20))),\n    )\n}\n\n// Status cards with colored borders\nfunc statusCard(content application.BaseWidget, status string) application.BaseWidget {\n    var borderColor string\n    switch status {\n    case \"success\":\n        borderColor = \"#10B981\"\n    case \"warning\":\n        borderColor = \"#F59E0B\"\n    case \"error\":\n        borderColor = \"#EF4444\"\n    default:\n        borderColor = \"#E5E7EB\"\n    }\n    \n    return container.New(\n        content,\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.BorderColor(borderColor),\n        container.BorderWidth(1, 1, 1, 3), // Thicker left border\n        container.Padding(breakpoint.All(spacing.All(16))),\n    )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Form Styling",
+		Anchor:  "form-styling",
+		Level:   3,
+		Text:    "Style form elements for better user experience.",
+		Code:    "// Form container\nfunc formContainer(children []application.BaseWidget) application.BaseWidget {\n    return container.New(\n        column.New(children, column.Gap(16)),\n        container.BackgroundColor(\"#FFFFFF\"),\n        container.BorderRadius(8),\n        container.Padding(breakpoint.All(spacing.All(24))),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n    )\n}\n\n// Form field with label\nfunc formField(label, placeholder string) application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            text.New(\n                label,\n                text.FontSize(14),\n                text.FontWeight(\"500\"),\n                text.FontColor(\"#374151\"),\n            ),\n            // Input field would go here\n            // This is where you'd add your input widget\n        },\n        column.Gap(6),\n    )\n}\n\n// Error message styling\nfunc errorMessage(message string) application.BaseWidget {\n    return text.New(\n        message,\n        text.FontSize(12),\n        text.FontColor(\"#EF4444\"),\n            )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Building a Design System",
+		Anchor:  "building-a-design-system",
+		Level:   2,
+		Text:    "Create a consistent design system for your application.",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Color Palette",
+		Anchor:  "color-palette",
+		Level:   3,
+		Text:    "Define a consistent color palette.",
+		Code:    "// Define your color palette\nconst (\n    // Primary colors\n    PrimaryBlue    = \"#2B799B\"\n    PrimaryDark    = \"#1F5A73\"\n    PrimaryLight   = \"#4A9BC7\"\n    \n    // Neutral colors\n    Gray900        = \"#1F2937\"\n    Gray800        = \"#374151\"\n    Gray700        = \"#4B5563\"\n    Gray600        = \"#6B7280\"\n    Gray500        = \"#9CA3AF\"\n    Gray400        = \"#D1D5DB\"\n    Gray300        = \"#E5E7EB\"\n    Gray200        = \"#F3F4F6\"\n    Gray100        = \"#F9FAFB\"\n    Gray50         = \"#FAFAFA\"\n    \n    // Semantic colors\n    Success        = \"#10B981\"\n    Warning        = \"#F59E0B\"\n    Error          = \"#EF4444\"\n    Info           = \"#3B82F6\"\n    \n    // Background colors\n    BackgroundWhite = \"#FFFFFF\"\n    BackgroundGray  = \"#F9FAFB\"\n    BackgroundDark  = \"#1F2937\"\n)\n\n// Use consistent colors throughout your app\ntext.New(\"Primary text\", text.FontColor(Gray900))\ntext.New(\"Secondary text\", text.FontColor(Gray600))\ncontainer.New(content, container.BackgroundColor(BackgroundWhite))",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Typography Scale",
+		Anchor:  "typography-scale",
+		Level:   3,
+		Text:    "Establish a typographic hierarchy.",
+		Code:    "// Typography scale\nconst (\n    // Font sizes\n    TextXS   = 10\n    TextSM   = 12\n    TextBase = 14\n    TextLG   = 16\n    TextXL   = 18\n    Text2XL  = 20\n    Text3XL  = 24\n    Text4XL  = 32\n    Text5XL  = 48\n    \n    // Font weights\n    FontThin      = \"100\"\n    FontLight     = \"300\"\n    FontNormal    = \"400\"\n    FontMedium    = \"500\"\n    FontSemibold  = \"600\"\n    FontBold      = \"700\"\n)\n\n// Consistent text components\nfunc headingXL(content string) application.BaseWidget {\n    return text.New(\n        content,\n        text.FontSize(Text4XL),\n        text.FontWeight(FontBold),\n        text.FontColor(Gray900),\n    )\n}\n\nfunc bodyText(content string) application.BaseWidget {\n    return text.New(\n        content,\n        text.FontSize(TextBase),\n        text.FontWeight(FontNormal),\n        text.FontColor(Gray700),\n        text.LineHeight(1.5),\n    )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Spacing Scale",
+		Anchor:  "spacing-scale",
+		Level:   3,
+		Text:    "Use consistent spacing throughout your application.",
+		Code:    "// Spacing scale (in pixels)\nconst (\n    Space1  = 4\n    Space2  = 8\n    Space3  = 12\n    Space4  = 16\n    Space5  = 20\n    Space6  = 24\n    Space8  = 32\n    Space10 = 40\n    Space12 = 48\n    Space16 = 64\n    Space20 = 80\n    Space24 = 96\n)\n\n// Helper functions for consistent spacing\nfunc cardPadding() spacing.Spacing {\n    return spacing.All(Space4) // 16px\n}\n\nfunc sectionMargin() application.BaseWidget {\n    return spacer.New(spacer.Height(Space8)) // 32px\n}\n\n// Use the scale consistently\nfunc card(content application.BaseWidget) application.BaseWidget {\n    return container.New(\n        content,\n        container.Padding(breakpoint.All(cardPadding())),\n    )\n}",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "Styling Best Practices",
+		Anchor:  "styling-best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines for effective styling. Establish a consistent color palette and stick to it throughout your application Use a typographic scale with defined font sizes and weights for hierarchy Create a spacing system and use it consistently for padding, margins, and gaps Start with mobile-first styling and enhance for larger screens Group related styling properties into reusable component functions Use semantic color names (success, warning, error) rather than specific colors Maintain sufficient color contrast for accessibility (4.5:1 for normal text) Test your styling across different screen sizes and browsers Keep styling simple and avoid over-decorating interfaces Use consistent border radius values throughout your design system Apply hover and focus states to interactive elements for better UX Document your design system and share it with your team",
+	},
+	{
+		Href:    "/docs/styling",
+		Page:    "Styling",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you understand styling, explore these related topics: State Management Handle dynamic content and user interactions in your layouts Events Learn how to handle user interactions and events",
+	},
+	{
+		Href: "/docs/state",
+		Page: "State Management",
+		Text: "State Management Learn how to manage dynamic data and create reactive user interfaces with gofred's powerful state management system.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Understanding State",
+		Anchor:  "understanding-state",
+		Level:   2,
+		Text:    "State represents data that can change over time in your application. In gofred, state management is built around hooks and reactive patterns that automatically update your UI when data changes.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Hooks & UseState",
+		Anchor:  "hooks-usestate",
+		Level:   2,
+		Text:    "Hooks are functions that let you use state and other gofred features in your widgets. The UseState hook is the foundation of state management.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Basic UseState",
+		Anchor:  "basic-usestate",
+		Level:   3,
+		Text:    "Create stateful values that can be updated and tracked.",
+		Code:    "package main\n\nimport (\n    \"fmt\"\n    \"github.com/gofred-io/gofred/hooks\"\n    \"github.com/gofred-io/gofred/listenable\"\n    \"github.com/gofred-io/gofred/foundation/button\"\n    \"github.com/gofred-io/gofred/foundation/column\"\n    \"github.com/gofred-io/gofred/foundation/text\"\n    \"github.com/gofred-io/gofred/application\"\n)\n\nvar (\n    // Declare state variables at package level\n    count, setCount = hooks.UseState(0)\n    name, setName   = hooks.UseState(\"World\")\n)\n\nfunc counterWidget() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Display current count with listenable.Builder\n            listenable.Builder(count, func() application.BaseWidget {\n                return text.New(\n                    fmt.Sprintf(\"Count: %d\", count.Value()),\n                    text.FontSize(18),\n                    text.FontWeight(\"700\"),\n                )\n            }),\n            \n            // Button to increment count\n            button.New(\n                text.New(\"Increment\"),\n                button.OnClick(func(this application.BaseWidget, e application.Event) {\n                    setCount(count.Value() + 1)\n                }),\n            ),\n        },\n        column.Gap(12),\n    )\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Multiple State Variables",
+		Anchor:  "multiple-state-variables",
+		Level:   3,
+		Text:    "Manage multiple pieces of state in your application.",
+		Code:    "var (\n    // User interface state\n    isLoggedIn, setIsLoggedIn = hooks.UseState(false)\n    username, setUsername     = hooks.UseState(\"\")\n    \n    // Application state\n    currentPage, setCurrentPage = hooks.UseState(\"home\")\n    isLoading, setIsLoading     = hooks.UseState(false)\n    \n    // Form state\n    email, setEmail       = hooks.UseState(\"\")\n    password, setPassword = hooks.UseState(\"\")\n    errors, setErrors     = hooks.UseState([]string{})\n)\n\n// Using multiple state variables\nfunc loginForm() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Show loading state\n            listenable.Builder(isLoading, func() application.BaseWidget {\n                if isLoading.Value() {\n                    return text.New(\"Logging in...\", text.FontColor(\"#6B7280\"))\n                }\n                return text.New(\"Please log in\", text.FontWeight(\"500\"))\n            }),\n            \n            // Email input (conceptual - actual input would be implemented)\n            // emailInput(),\n            \n            // Login button\n            listenable.Builder(email, func() application.BaseWidget {\n                return button.New(\n                    text.New(\"Login\"),\n                    button.OnClick(handleLogin),\n                    // Disable if email is empty\n                    button.Disabled(email.Value() == \"\"),\n                )\n            }),\n        },\n        column.Gap(16),\n    )\n}\n\nfunc handleLogin(this application.BaseWidget, e application.Event) {\n    setIsLoading(true)\n    // Simulate async login\n    go func() {\n        // ... login logic ...\n        setIsLoading(false)\n        setIsLoggedIn(true)\n        setUsername(email.Value())\n    }()\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Reactive UI with Listenable",
+		Anchor:  "reactive-ui-with-listenable",
+		Level:   2,
+		Text:    "The listenable.Builder function creates reactive UI components that automatically update when state changes.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Basic Reactive Components",
+		Anchor:  "basic-reactive-components",
+		Level:   3,
+		Text:    "Build components that respond to state changes.",
+		Code:    "// Theme switching example\nvar (\n    isDarkMode, setIsDarkMode = hooks.UseState(false)\n)\n\nfunc themeToggleWidget() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Display current theme\n            listenable.Builder(isDarkMode, func() application.BaseWidget {\n                theme := \"Light Mode\"\n                if isDarkMode.Value() {\n                    theme = \"Dark Mode\"\n                }\n                return text.New(\n                    fmt.Sprintf(\"Current theme: %s\", theme),\n                    text.FontSize(16),\n                )\n            }),\n            \n            // Toggle button\n            listenable.Builder(isDarkMode, func() application.BaseWidget {\n                label := \"Switch to Dark\"\n                if isDarkMode.Value() {\n                    label = \"Switch to Light\"\n                }\n                return button.New(\n                    text.New(label),\n                    button.OnClick(func(this application.BaseWidget, e application.Event) {\n                        setIsDarkMode(!isDarkMode.Value())\n                    }),\n                )\n            }),\n        },\n        column.Gap(12),\n    )\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Conditional Rendering",
+		Anchor:  "conditional-rendering",
+		Level:   3,
+		Text:    "Show different UI based on state values.",
+		Code:    "var (\n    user, setUser         = hooks.UseState[*User](nil)\n    isLoggedIn, setLoggedIn = hooks.UseState(false)\n)\n\ntype User struct {\n    Name  string\n    Email string\n    Role  string\n}\n\nfunc userProfileWidget() application.BaseWidget {\n    return listenable.Builder(isLoggedIn, func() application.BaseWidget {\n        if !isLoggedIn.Value() {\n            // Show login prompt\n            return container.New(\n                column.New(\n                    []application.BaseWidget{\n                        text.New(\"Please log in to continue\"),\n                        button.New(\n                            text.New(\"Login\"),\n                            button.OnClick(showLoginForm),\n                        ),\n                    },\n                    column.Gap(12),\n                ),\n                container.Padding(breakpoint.All(spacing.All(24))),\n            )\n        }\n        \n        // Show user profile\n        return listenable.Builder(user, func() application.BaseWidget {\n            currentUser := user.Value()\n            if currentUser == nil {\n                return text.New(\"Loading user data...\")\n            }\n            \n            return container.New(\n                column.New(\n                    []application.BaseWidget{\n                        text.New(\n                            fmt.Sprintf(\"Welcome, %s!\", currentUser.Name),\n                            text.FontSize(24),\n                            text.FontWeight(\"700\"),\n                        ),\n                        text.New(\n                            fmt.Sprintf(\"Email: %s\", currentUser.Email),\n                            text.FontColor(\"#6B7280\"),\n                        ),\n                        text.New(\n                            fmt.Sprintf(\"Role: %s\", currentUser.Role),\n                            text.FontColor(\"#6B7280\"),\n                        ),\n                        button.New(\n                            text.New(\"Logout\"),\n                            button.OnClick(handleLogout),\n                        ),\n                    },\n                    column.Gap(8),\n                ),\n                container.Padding(breakpoint.All(spacing.All(24))),\n            )\n        })\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Combining Multiple States",
+		Anchor:  "combining-multiple-states",
+		Level:   3,
+		Text:    "Create complex reactive UIs by combining multiple state variables.",
+		Code:    "var (\n    items, setItems       = hooks.UseState([]TodoItem{})\n    filter, setFilter     = hooks.UseState(\"all\") // \"all\", \"active\", \"completed\"\n    editingId, setEditingId = hooks.UseState(\"\")\n)\n\ntype TodoItem struct {\n    ID          string\n    Text        string\n    Completed   bool\n    CreatedAt   time.Time\n}\n\nfunc todoListWidget() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Filter buttons\n            listenable.Builder(filter, func() application.BaseWidget {\n                return row.New(\n                    []application.BaseWidget{\n                        filterButton(\"all\", \"All\"),\n                        filterButton(\"active\", \"Active\"),\n                        filterButton(\"completed\", \"Completed\"),\n                    },\n                    row.Gap(8),\n                )\n            }),\n            \n            // Todo items list\n            listenable.Builder(items, func() application.BaseWidget {\n                return listenable.Builder(filter, func() application.BaseWidget {\n                    filteredItems := getFilteredItems()\n                    \n                    var itemWidgets []application.BaseWidget\n                    for _, item := range filteredItems {\n                        itemWidgets = append(itemWidgets, todoItemWidget(item))\n                    }\n                    \n                    return column.New(itemWidgets, column.Gap(8))\n                })\n            }),\n            \n            // Add new item form\n            addTodoForm(),\n        },\n        column.Gap(16),\n    )\n}\n\nfunc filterButton(filterValue, label string) application.BaseWidget {\n    return listenable.Builder(filter, func() application.BaseWidget {\n        isActive := filter.Value() == filterValue\n        bgColor := \"#F3F4F6\"\n        textColor := \"#6B7280\"\n        \n        if isActive {\n            bgColor = \"#2B799B\"\n            textColor = \"#FFFFFF\"\n        }\n        \n        return button.New(\n            text.New(label, text.FontColor(textColor)),\n            button.BackgroundColor(bgColor),\n            button.OnClick(func(this application.BaseWidget, e application.Event) {\n                setFilter(filterValue)\n            }),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Advanced State Patterns",
+		Anchor:  "advanced-state-patterns",
+		Level:   2,
+		Text:    "Learn advanced patterns for managing complex application state.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "State Composition",
+		Anchor:  "state-composition",
+		Level:   3,
+		Text:    "Combine related state variables into cohesive patterns.",
+		Code:    "// Group related state together\ntype AppState struct {\n    User     *User\n    Theme    string\n    Language string\n    Settings map[string]interface{}\n}\n\nvar (\n    appState, setAppState = hooks.UseState(AppState{\n        Theme:    \"light\",\n        Language: \"en\",\n        Settings: make(map[string]interface{}),\n    })\n)\n\n// Helper functions for updating specific parts of state\nfunc updateUserProfile(newUser *User) {\n    currentState := appState.Value()\n    currentState.User = newUser\n    setAppState(currentState)\n}\n\nfunc updateTheme(newTheme string) {\n    currentState := appState.Value()\n    currentState.Theme = newTheme\n    setAppState(currentState)\n}\n\nfunc updateSetting(key string, value interface{}) {\n    currentState := appState.Value()\n    currentState.Settings[key] = value\n    setAppState(currentState)\n}\n\n// Using composed state\nfunc settingsPanel() application.BaseWidget {\n    return listenable.Builder(appState, func() application.BaseWidget {\n        state := appState.Value()\n        \n        return column.New(\n            []application.BaseWidget{\n                // Theme setting\n                row.New(\n                    []application.BaseWidget{\n                        text.New(\"Theme:\", text.FontWeight(\"500\")),\n                        text.New(state.Theme, text.FontColor(\"#6B7280\")),\n                        button.New(\n                            text.New(\"Change\"),\n                            button.OnClick(func(this application.BaseWidget, e application.Event) {\n                                newTheme := \"dark\"\n                                if state.Theme == \"dark\" {\n                                    newTheme = \"light\"\n                                }\n                                updateTheme(newTheme)\n                            }),\n                        ),\n                    },\n                    row.Gap(8),\n                ),\n                \n                // Language setting\n                row.New(\n                    []application.BaseWidget{\n                        text.New(\"Language:\", text.FontWeight(\"500\")),\n                        text.New(state.Language, text.FontColor(\"#6B7280\")),\n                    },\n                    row.Gap(8),\n                ),\n            },\n            column.Gap(12),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Async State Management",
+		Anchor:  "async-state-management",
+		Level:   3,
+		Text:    "Handle asynchronous operations and loading states.",
+		Code:    "type AsyncState[T any] struct {\n    Data    T\n    Loading bool\n    Error   error\n}\n\nvar (\n    userProfile, setUserProfile = hooks.UseState(AsyncState[*User]{\n        Loading: false,\n        Error:   nil,\n    })\n    \n    todoList, setTodoList = hooks.UseState(AsyncState[[]TodoItem]{\n        Data:    []TodoItem{},\n        Loading: false,\n        Error:   nil,\n    })\n)\n\n// Helper functions for async operations\nfunc loadUserProfile(userID string) {\n    // Set loading state\n    setUserProfile(AsyncState[*User]{\n        Loading: true,\n        Error:   nil,\n    })\n    \n    // Simulate async operation\n    go func() {\n        user, err := fetchUserFromAPI(userID)\n        \n        if err != nil {\n            setUserProfile(AsyncState[*User]{\n                Loading: false,\n                Error:   err,\n            })\n            return\n        }\n        \n        setUserProfile(AsyncState[*User]{\n            Data:    user,\n            Loading: false,\n            Error:   nil,\n        })\n    }()\n}\n\n// UI that handles async state\nfunc userProfileAsyncWidget() application.BaseWidget {\n    return listenable.Builder(userProfile, func() application.BaseWidget {\n        state := userProfile.Value()\n        \n        if state.Loading {\n            return column.New(\n                []application.BaseWidget{\n                    text.New(\"Loading user profile...\", text.FontColor(\"#6B7280\")),\n                    // You could add a spinner here\n                },\n                column.Gap(8),\n            )\n        }\n        \n        if state.Error != nil {\n            return column.New(\n                []application.BaseWidget{\n                    text.New(\n                        fmt.Sprintf(\"Error: %s\", state.Error.Error()),\n                        text.FontColor(\"#EF4444\"),\n                    ),\n                    button.New(\n                        text.New(\"Retry\"),\n                        button.OnClick(func(this application.BaseWidget, e application.Event) {\n                            loadUserProfile(\"current-user-id\")\n                        }),\n                    ),\n                },\n                column.Gap(8),\n            )\n        }\n        \n        user := state.Data\n        if user == nil {\n            return text.New(\"No user data available\")\n        }\n        \n        return column.New(\n            []application.BaseWidget{\n                text.New(\n                    fmt.Sprintf(\"Welcome, %s!\", user.Name),\n                    text.FontSize(24),\n                    text.FontWeight(\"700\"),\n                ),\n                text.New(user.Email, text.FontColor(\"#6B7280\")),\n            },\n            column.Gap(8),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "State Validation",
+		Anchor:  "state-validation",
+		Level:   3,
+		Text:    "Implement validation and error handling in your state.",
+		Code:    "type FormState struct {\n    Values map[string]string\n    Errors map[string]string\n    Touched map[string]bool\n    IsValid bool\n}\n\nvar (\n    formState, setFormState = hooks.UseState(FormState{\n        Values:  make(map[string]string),\n        Errors:  make(map[string]string),\n        Touched: make(map[string]bool),\n        IsValid: false,\n    })\n)\n\n// Validation functions\nfunc validateEmail(email string) string {\n    if email == \"\" {\n        return \"Email is required\"\n    }\n    if !strings.Contains(email, \"@\") {\n        return \"Invalid email format\"\n    }\n    return \"\"\n}\n\nfunc validatePassword(password string) string {\n    if password == \"\" {\n        return \"Password is required\"\n    }\n    if len(password) < 8 {\n        return \"Password must be at least 8 characters\"\n    }\n    return \"\"\n}\n\n// Helper functions for form state management\nfunc updateFormValue(field, value string) {\n    state := formState.Value()\n    state.Values[field] = value\n    state.Touched[field] = true\n    \n    // Validate the field\n    var errorMsg string\n    switch field {\n    case \"email\":\n        errorMsg = validateEmail(value)\n    case \"password\":\n        errorMsg = validatePassword(value)\n    }\n    \n    if errorMsg != \"\" {\n        state.Errors[field] = errorMsg\n    } else {\n        delete(state.Errors, field)\n    }\n    \n    // Check if form is valid\n    state.IsValid = len(state.Errors) == 0 && \n                   state.Values[\"email\"] != \"\" && \n                   state.Values[\"password\"] != \"\"\n    \n    setFormState(state)\n}\n\n// Form component with validation\nfunc validatedForm() application.BaseWidget {\n    return listenable.Builder(formState, func() application.BaseWidget {\n        state := formState.Value()\n        \n        return column.New(\n            []application.BaseWidget{\n                // Email field\n                column.New(\n                    []application.BaseWidget{\n                        text.New(\"Email\", text.FontWeight(\"500\")),\n                        // Email input would go here\n                        text.New(\n                            fmt.Sprintf(\"Current: %s\", state.Values[\"email\"]),\n                            text.FontSize(12),\n                            text.FontColor(\"#6B7280\"),\n                        ),\n                        // Show error if field is touched and has error\n                        func() application.BaseWidget {\n                            if state.Touched[\"email\"] && state.Errors[\"email\"] != \"\" {\n                                return text.New(\n                                    state.Errors[\"email\"],\n                                    text.FontSize(12),\n                                    text.FontColor(\"#EF4444\"),\n                                )\n                            }\n                            return spacer.New(spacer.Height(0))\n                        }(),\n                    },\n                    column.Gap(4),\n                ),\n                \n                // Submit button\n                button.New(\n                    text.New(\"Submit\"),\n                    button.OnClick(func(this application.BaseWidget, e application.Event) {\n                        if state.IsValid {\n                            handleFormSubmit()\n                        }\n                    }),\n                    // Disable if form is not valid\n                    button.Disabled(!state.IsValid),\n                ),\n            },\n            column.Gap(16),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Global State Management",
+		Anchor:  "global-state-management",
+		Level:   2,
+		Text:    "Share state across your entire application with global state patterns.",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Application Store",
+		Anchor:  "application-store",
+		Level:   3,
+		Text:    "Create a central store for application-wide state.",
+		Code:    "// store/app_store.go\npackage store\n\nimport (\n    \"github.com/gofred-io/gofred/hooks\"\n    \"github.com/gofred-io/gofred/listenable\"\n)\n\ntype AppStore struct {\n    User        *User\n    Theme       string\n    Notifications []Notification\n    Router      RouterState\n}\n\ntype User struct {\n    Name  string\n    Email string\n}\n\ntype Notification struct {\n    ID      string\n    Message string\n    Type    string // \"info\", \"success\", \"warning\", \"error\"\n}\n\ntype RouterState struct {\n    CurrentPath string\n    History     []string\n}\n\nvar (\n    // Global application store\n    appStore, setAppStore = hooks.UseState(AppStore{\n        Theme: \"light\",\n        Notifications: []Notification{},\n        Router: RouterState{\n            CurrentPath: \"/\",\n            History:     []string{\"/\"},\n        },\n    })\n)\n\n// Store getters\nfunc GetUser() *User {\n    return appStore.Value().User\n}\n\nfunc GetTheme() string {\n    return appStore.Value().Theme\n}\n\nfunc GetNotifications() []Notification {\n    return appStore.Value().Notifications\n}\n\n// Store actions\nfunc SetUser(user *User) {\n    store := appStore.Value()\n    store.User = user\n    setAppStore(store)\n}\n\nfunc SetTheme(theme string) {\n    store := appStore.Value()\n    store.Theme = theme\n    setAppStore(store)\n}\n\nfunc AddNotification(notification Notification) {\n    store := appStore.Value()\n    store.Notifications = append(store.Notifications, notification)\n    setAppStore(store)\n}\n\nfunc RemoveNotification(id string) {\n    store := appStore.Value()\n    var filtered []Notification\n    for _, notif := range store.Notifications {\n        if notif.ID != id {\n            filtered = append(filtered, notif)\n        }\n    }\n    store.Notifications = filtered\n    setAppStore(store)\n}\n\n// Store listenable for reactive UI\nfunc AppStoreListenable() listenable.Listenable[AppStore] {\n    return appStore\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "Using Global State",
+		Anchor:  "using-global-state",
+		Level:   3,
+		Text:    "Access and use global state in your components.",
+		Code:    "// Using the global store in components\nimport \"your-app/store\"\n\nfunc notificationBar() application.BaseWidget {\n    return listenable.Builder(store.AppStoreListenable(), func() application.BaseWidget {\n        notifications := store.GetNotifications()\n        \n        if len(notifications) == 0 {\n            return spacer.New(spacer.Height(0))\n        }\n        \n        var notifWidgets []application.BaseWidget\n        for _, notif := range notifications {\n            notifWidgets = append(notifWidgets, notificationWidget(notif))\n        }\n        \n        return column.New(notifWidgets, column.Gap(8))\n    })\n}\n\nfunc notificationWidget(notif store.Notification) application.BaseWidget {\n    var bgColor, textColor string\n    switch notif.Type {\n    case \"success\":\n        bgColor = \"#10B981\"\n        textColor = \"#FFFFFF\"\n    case \"error\":\n        bgColor = \"#EF4444\"\n        textColor = \"#FFFFFF\"\n    case \"warning\":\n        bgColor = \"#F59E0B\"\n        textColor = \"#FFFFFF\"\n    default:\n        bgColor = \"#3B82F6\"\n        textColor = \"#FFFFFF\"\n    }\n    \n    return container.New(\n        row.New(\n            []application.BaseWidget{\n                text.New(notif.Message, text.FontColor(textColor)),\n                spacer.New(),\n                button.New(\n                    text.New(\"×\", text.FontColor(textColor)),\n                    button.BackgroundColor(\"transparent\"),\n                    button.OnClick(func(this application.BaseWidget, e application.Event) {\n                        store.RemoveNotification(notif.ID)\n                    }),\n                ),\n            },\n            row.Gap(8),\n            row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n        ),\n        container.BackgroundColor(bgColor),\n        container.Padding(breakpoint.All(spacing.All(12))),\n        container.BorderRadius(6),\n    )\n}\n\n// Theme-aware component\nfunc themedButton(label string, onClick func(application.BaseWidget, application.Event)) application.BaseWidget {\n    return listenable.Builder(store.AppStoreListenable(), func() application.BaseWidget {\n        theme := store.GetTheme()\n        \n        var bgColor, textColor string\n        if theme == \"dark\" {\n            bgColor = \"#374151\"\n            textColor = \"#F9FAFB\"\n        } else {\n            bgColor = \"#2B799B\"\n            textColor = \"#FFFFFF\"\n        }\n        \n        return button.New(\n            text.New(label, text.FontColor(textColor)),\n            button.BackgroundColor(bgColor),\n            button.OnClick(onClick),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "State Management Best Practices",
+		Anchor:  "state-management-best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines for effective state management. Declare state variables at package level for global access and persistence Use descriptive names for state variables and their setters (e.g., user, setUser) Keep state immutable - create new objects rather than modifying existing ones Use listenable.Builder to create reactive UI components that respond to state changes Group related state variables together for better organization Handle loading and error states explicitly in async operations Validate state changes and provide user feedback for invalid inputs Use helper functions to encapsulate complex state update logic Keep state updates simple and predictable - avoid side effects in setters Consider using global state stores for application-wide data Test state changes thoroughly, especially async operations and error conditions Document your state structure and update patterns for team collaboration",
+	},
+	{
+		Href:    "/docs/state",
+		Page:    "State Management",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you understand state management, explore these related topics: Events Learn how to handle user interactions and create event-driven applications",
+	},
+	{
+		Href: "/docs/events",
+		Page: "Event Handling",
+		Text: "Event Handling Learn how to handle user interactions and create responsive, interactive applications with gofred's event system.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Understanding Events",
+		Anchor:  "understanding-events",
+		Level:   2,
+		Text:    "Events are actions that users perform in your application, such as clicking buttons, typing in inputs, or navigating between pages. gofred provides a simple and powerful event handling system that integrates seamlessly with state management.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Basic Event Handling",
+		Anchor:  "basic-event-handling",
+		Level:   2,
+		Text:    "Event handlers are functions that respond to user interactions. They receive the widget that triggered the event and an event object containing details about the interaction.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "OnClick Events",
+		Anchor:  "onclick-events",
+		Level:   3,
+		Text:    "The most common event type for handling button clicks and user interactions.",
+		Code:    "package main\n\nimport (\n    \"fmt\"\n    \"github.com/gofred-io/gofred/foundation/button\"\n    \"github.com/gofred-io/gofred/foundation/text\"\n    \"github.com/gofred-io/gofred/application\"\n)\n\n// Basic click handler\nfunc simpleButton() application.BaseWidget {\n    return button.New(\n        text.New(\"Click Me\"),\n        button.OnClick(handleSimpleClick),\n    )\n}\n\n// Event handler function signature\nfunc handleSimpleClick(this application.BaseWidget, e application.Event) {\n    fmt.Println(\"Button was clicked!\")\n    // 'this' is the widget that was clicked\n    // 'e' contains event details\n}\n\n// Inline event handler\nfunc inlineHandlerButton() application.BaseWidget {\n    return button.New(\n        text.New(\"Inline Handler\"),\n        button.OnClick(func(this application.BaseWidget, e application.Event) {\n            fmt.Println(\"Inline handler executed!\")\n            // You can access variables from the surrounding scope\n        }),\n    )\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Event Handler Patterns",
+		Anchor:  "event-handler-patterns",
+		Level:   3,
+		Text:    "Different patterns for organizing and implementing event handlers.",
+		Code:    "// Named handler functions (recommended for reusability)\nfunc saveButton() application.BaseWidget {\n    return button.New(\n        text.New(\"Save\"),\n        button.OnClick(handleSave),\n    )\n}\n\nfunc handleSave(this application.BaseWidget, e application.Event) {\n    // Dedicated handler function\n    fmt.Println(\"Saving data...\")\n}\n\n// Closure handlers (for accessing local state)\nfunc counterButton(count int, onIncrement func()) application.BaseWidget {\n    return button.New(\n        text.New(fmt.Sprintf(\"Count: %d\", count)),\n        button.OnClick(func(this application.BaseWidget, e application.Event) {\n            // Access closure variables\n            onIncrement()\n        }),\n    )\n}\n\n// Method handlers (for widget-based components)\ntype TodoList struct {\n    items []string\n}\n\nfunc (tl *TodoList) addButton() application.BaseWidget {\n    return button.New(\n        text.New(\"Add Item\"),\n        button.OnClick(tl.handleAddItem),\n    )\n}\n\nfunc (tl *TodoList) handleAddItem(this application.BaseWidget, e application.Event) {\n    tl.items = append(tl.items, \"New Item\")\n    // Update state or trigger re-render\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Events with State Management",
+		Anchor:  "events-with-state-management",
+		Level:   2,
+		Text:    "Combine events with state management to create dynamic, reactive user interfaces.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "State Updates from Events",
+		Anchor:  "state-updates-from-events",
+		Level:   3,
+		Text:    "Update application state in response to user interactions.",
+		Code:    "import (\n    \"github.com/gofred-io/gofred/hooks\"\n    \"github.com/gofred-io/gofred/listenable\"\n)\n\nvar (\n    count, setCount = hooks.UseState(0)\n    message, setMessage = hooks.UseState(\"Hello\")\n    isVisible, setIsVisible = hooks.UseState(true)\n)\n\n// Counter with state updates\nfunc counterWidget() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Display current count\n            listenable.Builder(count, func() application.BaseWidget {\n                return text.New(\n                    fmt.Sprintf(\"Count: %d\", count.Value()),\n                    text.FontSize(18),\n                    text.FontWeight(\"700\"),\n                )\n            }),\n            \n            // Increment button\n            button.New(\n                text.New(\"Increment\"),\n                button.OnClick(func(this application.BaseWidget, e application.Event) {\n                    setCount(count.Value() + 1)\n                }),\n            ),\n            \n            // Decrement button\n            button.New(\n                text.New(\"Decrement\"),\n                button.OnClick(func(this application.BaseWidget, e application.Event) {\n                    setCount(count.Value() - 1)\n                }),\n            ),\n            \n            // Reset button\n            button.New(\n                text.New(\"Reset\"),\n                button.OnClick(func(this application.BaseWidget, e application.Event) {\n                    setCount(0)\n                }),\n            ),\n        },\n        column.Gap(8),\n    )\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Conditional Event Handling",
+		Anchor:  "conditional-event-handling",
+		Level:   3,
+		Text:    "Handle events differently based on current state.",
+		Code:    "var (\n    isLoggedIn, setIsLoggedIn = hooks.UseState(false)\n    user, setUser = hooks.UseState[*User](nil)\n)\n\ntype User struct {\n    Name  string\n    Email string\n}\n\nfunc loginButton() application.BaseWidget {\n    return listenable.Builder(isLoggedIn, func() application.BaseWidget {\n        if isLoggedIn.Value() {\n            // Show logout button when logged in\n            return button.New(\n                text.New(\"Logout\"),\n                button.BackgroundColor(\"#EF4444\"),\n                button.OnClick(handleLogout),\n            )\n        } else {\n            // Show login button when logged out\n            return button.New(\n                text.New(\"Login\"),\n                button.BackgroundColor(\"#10B981\"),\n                button.OnClick(handleLogin),\n            )\n        }\n    })\n}\n\nfunc handleLogin(this application.BaseWidget, e application.Event) {\n    // Simulate login process\n    fmt.Println(\"Logging in...\")\n    \n    // Set user data\n    setUser(&User{\n        Name:  \"John Doe\",\n        Email: \"john@example.com\",\n    })\n    \n    // Update login state\n    setIsLoggedIn(true)\n}\n\nfunc handleLogout(this application.BaseWidget, e application.Event) {\n    fmt.Println(\"Logging out...\")\n    \n    // Clear user data\n    setUser(nil)\n    setIsLoggedIn(false)\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Form Events and Validation",
+		Anchor:  "form-events-and-validation",
+		Level:   3,
+		Text:    "Handle form submissions and input validation.",
+		Code:    "var (\n    formData, setFormData = hooks.UseState(map[string]string{\n        \"email\":    \"\",\n        \"password\": \"\",\n    })\n    formErrors, setFormErrors = hooks.UseState(map[string]string{})\n    isSubmitting, setIsSubmitting = hooks.UseState(false)\n)\n\nfunc loginForm() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Email field (conceptual - actual input implementation would be here)\n            text.New(\"Email:\"),\n            \n            // Password field (conceptual)\n            text.New(\"Password:\"),\n            \n            // Show validation errors\n            listenable.Builder(formErrors, func() application.BaseWidget {\n                errors := formErrors.Value()\n                if len(errors) == 0 {\n                    return spacer.New(spacer.Height(0))\n                }\n                \n                var errorWidgets []application.BaseWidget\n                for field, msg := range errors {\n                    errorWidgets = append(errorWidgets, text.New(\n                        fmt.Sprintf(\"%s: %s\", field, msg),\n                        text.FontColor(\"#EF4444\"),\n                        text.FontSize(12),\n                    ))\n                }\n                \n                return column.New(errorWidgets, column.Gap(4))\n            }),\n            \n            // Submit button\n            listenable.Builder(isSubmitting, func() application.BaseWidget {\n                if isSubmitting.Value() {\n                    return button.New(\n                        text.New(\"Submitting...\"),\n                        button.Disabled(true),\n                    )\n                }\n                \n                return button.New(\n                    text.New(\"Submit\"),\n                    button.OnClick(handleFormSubmit),\n                )\n            }),\n        },\n        column.Gap(12),\n    )\n}\n\nfunc handleFormSubmit(this application.BaseWidget, e application.Event) {\n    // Prevent double submission\n    if isSubmitting.Value() {\n        return\n    }\n    \n    // Validate form\n    data := formData.Value()\n    errors := make(map[string]string)\n    \n    if data[\"email\"] == \"\" {\n        errors[\"email\"] = \"Email is required\"\n    }\n    if data[\"password\"] == \"\" {\n        errors[\"password\"] = \"Password is required\"\n    }\n    \n    setFormErrors(errors)\n    \n    if len(errors) > 0 {\n        return\n    }\n    \n    // Start submission\n    setIsSubmitting(true)\n    \n    // Simulate async form submission\n    go func() {\n        // ... submit data to server ...\n        \n        // Reset form on success\n        setFormData(map[string]string{\n            \"email\":    \"\",\n            \"password\": \"\",\n        })\n        setIsSubmitting(false)\n    }()\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Advanced Event Patterns",
+		Anchor:  "advanced-event-patterns",
+		Level:   2,
+		Text:    "Learn sophisticated patterns for handling complex user interactions.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Event Delegation and Bubbling",
+		Anchor:  "event-delegation-and-bubbling",
+		Level:   3,
+		Text:    "Handle events from multiple similar widgets efficiently.",
+		Code:    "type TodoItem struct {\n    ID        string\n    Text      string\n    Completed bool\n}\n\nvar (\n    todos, setTodos = hooks.UseState([]TodoItem{\n        {ID: \"1\", Text: \"Learn gofred\", Completed: false},\n        {ID: \"2\", Text: \"Build an app\", Completed: false},\n    })\n)\n\nfunc todoList() application.BaseWidget {\n    return listenable.Builder(todos, func() application.BaseWidget {\n        items := todos.Value()\n        \n        var todoWidgets []application.BaseWidget\n        for _, item := range items {\n            todoWidgets = append(todoWidgets, todoItemWidget(item))\n        }\n        \n        return column.New(todoWidgets, column.Gap(8))\n    })\n}\n\nfunc todoItemWidget(item TodoItem) application.BaseWidget {\n    return container.New(\n        row.New(\n            []application.BaseWidget{\n                // Toggle completion\n                button.New(\n                    text.New(func() string {\n                        if item.Completed {\n                            return \"✓\"\n                        }\n                        return \"○\"\n                    }()),\n                    button.OnClick(func(this application.BaseWidget, e application.Event) {\n                        toggleTodo(item.ID)\n                    }),\n                ),\n                \n                // Todo text\n                text.New(\n                    item.Text,\n                    text.FontColor(func() string {\n                        if item.Completed {\n                            return \"#9CA3AF\"\n                        }\n                        return \"#1F2937\"\n                    }()),\n                ),\n                \n                spacer.New(),\n                \n                // Delete button\n                button.New(\n                    text.New(\"Delete\"),\n                    button.BackgroundColor(\"#EF4444\"),\n                    button.OnClick(func(this application.BaseWidget, e application.Event) {\n                        deleteTodo(item.ID)\n                    }),\n                ),\n            },\n            row.Gap(12),\n            row.CrossAxisAlignment(theme.AxisAlignmentTypeCenter),\n        ),\n        container.Padding(breakpoint.All(spacing.All(12))),\n        container.BorderColor(\"#E5E7EB\"),\n        container.BorderWidth(spacing.All(1)),\n        container.BorderRadius(6),\n    )\n}\n\n// Event handlers for todo operations\nfunc toggleTodo(id string) {\n    items := todos.Value()\n    for i, item := range items {\n        if item.ID == id {\n            items[i].Completed = !items[i].Completed\n            break\n        }\n    }\n    setTodos(items)\n}\n\nfunc deleteTodo(id string) {\n    items := todos.Value()\n    var filtered []TodoItem\n    for _, item := range items {\n        if item.ID != id {\n            filtered = append(filtered, item)\n        }\n    }\n    setTodos(filtered)\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Async Event Handling",
+		Anchor:  "async-event-handling",
+		Level:   3,
+		Text:    "Handle events that trigger asynchronous operations.",
+		Code:    "var (\n    userData, setUserData = hooks.UseState[*User](nil)\n    isLoading, setIsLoading = hooks.UseState(false)\n    loadError, setLoadError = hooks.UseState[error](nil)\n)\n\nfunc userProfile() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Load user button\n            button.New(\n                text.New(\"Load User Profile\"),\n                button.OnClick(handleLoadUser),\n            ),\n            \n            // Loading state\n            listenable.Builder(isLoading, func() application.BaseWidget {\n                if isLoading.Value() {\n                    return text.New(\"Loading...\", text.FontColor(\"#6B7280\"))\n                }\n                return spacer.New(spacer.Height(0))\n            }),\n            \n            // Error state\n            listenable.Builder(loadError, func() application.BaseWidget {\n                err := loadError.Value()\n                if err != nil {\n                    return column.New(\n                        []application.BaseWidget{\n                            text.New(\n                                fmt.Sprintf(\"Error: %s\", err.Error()),\n                                text.FontColor(\"#EF4444\"),\n                            ),\n                            button.New(\n                                text.New(\"Retry\"),\n                                button.OnClick(handleLoadUser),\n                            ),\n                        },\n                        column.Gap(8),\n                    )\n                }\n                return spacer.New(spacer.Height(0))\n            }),\n            \n            // User data\n            listenable.Builder(userData, func() application.BaseWidget {\n                user := userData.Value()\n                if user == nil {\n                    return text.New(\"No user data\")\n                }\n                \n                return column.New(\n                    []application.BaseWidget{\n                        text.New(\n                            fmt.Sprintf(\"Name: %s\", user.Name),\n                            text.FontWeight(\"700\"),\n                        ),\n                        text.New(\n                            fmt.Sprintf(\"Email: %s\", user.Email),\n                            text.FontColor(\"#6B7280\"),\n                        ),\n                        button.New(\n                            text.New(\"Refresh\"),\n                            button.OnClick(handleLoadUser),\n                        ),\n                    },\n                    column.Gap(8),\n                )\n            }),\n        },\n        column.Gap(16),\n    )\n}\n\nfunc handleLoadUser(this application.BaseWidget, e application.Event) {\n    // Prevent multiple simultaneous requests\n    if isLoading.Value() {\n        return\n    }\n    \n    // Clear previous error\n    setLoadError(nil)\n    setIsLoading(true)\n    \n    // Simulate async API call\n    go func() {\n        // Simulate network delay\n        time.Sleep(2 * time.Second)\n        \n        // Simulate random success/failure\n        if rand.Float32() > 0.7 {\n            setLoadError(fmt.Errorf(\"failed to load user data\"))\n            setIsLoading(false)\n            return\n        }\n        \n        // Success - set user data\n        setUserData(&User{\n            Name:  \"Jane Doe\",\n            Email: \"jane@example.com\",\n        })\n        setIsLoading(false)\n    }()\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Event Composition and Higher-Order Handlers",
+		Anchor:  "event-composition-and-higher-order-handlers",
+		Level:   3,
+		Text:    "Create reusable event handling patterns.",
+		Code:    "// Higher-order function for debounced events\nfunc debounceHandler(delay time.Duration, handler func(application.BaseWidget, application.Event)) func(application.BaseWidget, application.Event) {\n    var timer *time.Timer\n    \n    return func(this application.BaseWidget, e application.Event) {\n        if timer != nil {\n            timer.Stop()\n        }\n        \n        timer = time.AfterFunc(delay, func() {\n            handler(this, e)\n        })\n    }\n}\n\n// Higher-order function for event confirmation\nfunc confirmHandler(message string, handler func(application.BaseWidget, application.Event)) func(application.BaseWidget, application.Event) {\n    return func(this application.BaseWidget, e application.Event) {\n        // In a real implementation, you'd show a confirmation dialog\n        confirmed := true // Simulate user confirmation\n        \n        if confirmed {\n            handler(this, e)\n        }\n    }\n}\n\n// Usage examples\nfunc enhancedButton() application.BaseWidget {\n    return column.New(\n        []application.BaseWidget{\n            // Debounced search button\n            button.New(\n                text.New(\"Search\"),\n                button.OnClick(debounceHandler(300*time.Millisecond, func(this application.BaseWidget, e application.Event) {\n                    fmt.Println(\"Performing search...\")\n                    // Search logic here\n                })),\n            ),\n            \n            // Confirmed delete button\n            button.New(\n                text.New(\"Delete All\"),\n                button.BackgroundColor(\"#EF4444\"),\n                button.OnClick(confirmHandler(\"Are you sure you want to delete all items?\", func(this application.BaseWidget, e application.Event) {\n                    fmt.Println(\"Deleting all items...\")\n                    // Delete logic here\n                })),\n            ),\n        },\n        column.Gap(12),\n    )\n}\n\n// Event handler composition\nfunc compositeHandler(handlers ...func(application.BaseWidget, application.Event)) func(application.BaseWidget, application.Event) {\n    return func(this application.BaseWidget, e application.Event) {\n        for _, handler := range handlers {\n            handler(this, e)\n        }\n    }\n}\n\n// Analytics and logging wrapper\nfunc analyticsHandler(eventName string, handler func(application.BaseWidget, application.Event)) func(application.BaseWidget, application.Event) {\n    return func(this application.BaseWidget, e application.Event) {\n        // Log analytics event\n        fmt.Printf(\"Analytics: %s triggered\\n\", eventName)\n        \n        // Call original handler\n        handler(this, e)\n    }\n}\n\n// Usage with composition\nfunc trackedButton() application.BaseWidget {\n    return button.New(\n        text.New(\"Tracked Action\"),\n        button.OnClick(compositeHandler(\n            analyticsHandler(\"button_clicked\", func(this application.BaseWidget, e application.Event) {\n                fmt.Println(\"Primary action executed\")\n            }),\n            func(this application.BaseWidget, e application.Event) {\n                fmt.Println(\"Secondary action executed\")\n            },\n        )),\n    )\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Navigation and Routing Events",
+		Anchor:  "navigation-and-routing-events",
+		Level:   2,
+		Text:    "Handle navigation events and create multi-page applications.",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Link Events",
+		Anchor:  "link-events",
+		Level:   3,
+		Text:    "Handle navigation between pages and routes.",
+		Code:    "import (\n    \"github.com/gofred-io/gofred/foundation/link\"\n)\n\nvar (\n    currentPage, setCurrentPage = hooks.UseState(\"home\")\n    history, setHistory = hooks.UseState([]string{\"home\"})\n)\n\n// Navigation links\nfunc navigationBar() application.BaseWidget {\n    return row.New(\n        []application.BaseWidget{\n            navLink(\"home\", \"Home\"),\n            navLink(\"about\", \"About\"),\n            navLink(\"contact\", \"Contact\"),\n            navLink(\"docs\", \"Documentation\"),\n        },\n        row.Gap(16),\n    )\n}\n\nfunc navLink(page, label string) application.BaseWidget {\n    return listenable.Builder(currentPage, func() application.BaseWidget {\n        isActive := currentPage.Value() == page\n        \n        textColor := \"#6B7280\"\n        if isActive {\n            textColor = \"#2B799B\"\n        }\n        \n        return link.New(\n            text.New(label, text.FontColor(textColor)),\n            link.Href(fmt.Sprintf(\"/%s\", page)),\n            link.OnClick(func(this application.BaseWidget, e application.Event) {\n                navigateToPage(page)\n            }),\n        )\n    })\n}\n\nfunc navigateToPage(page string) {\n    // Update current page\n    setCurrentPage(page)\n    \n    // Add to history\n    hist := history.Value()\n    hist = append(hist, page)\n    setHistory(hist)\n    \n    // In a real app, you might also update the browser URL\n    fmt.Printf(\"Navigated to: %s\\n\", page)\n}\n\n// Back button functionality\nfunc backButton() application.BaseWidget {\n    return listenable.Builder(history, func() application.BaseWidget {\n        hist := history.Value()\n        canGoBack := len(hist) > 1\n        \n        return button.New(\n            text.New(\"← Back\"),\n            button.Disabled(!canGoBack),\n            button.OnClick(func(this application.BaseWidget, e application.Event) {\n                if canGoBack {\n                    // Remove current page from history\n                    newHist := hist[:len(hist)-1]\n                    setHistory(newHist)\n                    \n                    // Go to previous page\n                    previousPage := newHist[len(newHist)-1]\n                    setCurrentPage(previousPage)\n                }\n            }),\n        )\n    })\n}",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "Event Handling Best Practices",
+		Anchor:  "event-handling-best-practices",
+		Level:   2,
+		Text:    "Follow these guidelines for effective event handling. Use named functions for event handlers when they can be reused across components Keep event handlers focused and delegate complex logic to separate functions Prevent multiple submissions or rapid clicks by checking loading states Always handle async operations with proper loading and error states Use closure patterns to access local state and variables in event handlers Implement proper form validation before processing user input Consider debouncing events that might fire frequently (like search input) Use confirmation dialogs for destructive actions like deletions Log important user interactions for analytics and debugging purposes Test event handlers thoroughly, including edge cases and error conditions Clean up resources and timers in event handlers when components unmount Use higher-order functions to create reusable event handling patterns",
+	},
+	{
+		Href:    "/docs/events",
+		Page:    "Event Handling",
+		Section: "What's Next?",
+		Anchor:  "whats-next",
+		Level:   2,
+		Text:    "Now that you understand event handling, explore these related topics: Examples See complete examples combining widgets, layouts, styling, state, and events",
+	},
+}
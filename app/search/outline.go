@@ -0,0 +1,83 @@
+package search
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	wordsPerMinute = 200
+)
+
+// Outline returns the headings of the page at href in page order
+func Outline(href string) []Document {
+	var headings []Document
+	for _, doc := range documents {
+		if doc.Href == href && doc.Anchor != "" {
+			headings = append(headings, doc)
+		}
+	}
+	return headings
+}
+
+// ReadingTime returns the minutes it takes to read the page at href, at
+// wordsPerMinute for prose and code alike, or 0 for pages not in the index
+func ReadingTime(href string) int {
+	words := 0
+	for _, doc := range documents {
+		if doc.Href == href {
+			words += len(strings.Fields(doc.Text)) + len(strings.Fields(doc.Code))
+		}
+	}
+	if words == 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
+
+// Anchor turns a heading into a fragment ID, like "Hooks & UseState" into
+// "hooks-usestate"
+func Anchor(heading string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range lower(heading) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		// Apostrophes join words, so "What's Next" becomes whats-next
+		if r != '\'' && r != '’' {
+			dash = true
+		}
+	}
+	if b.Len() == 0 {
+		return "section"
+	}
+	return b.String()
+}
+
+// Anchors gives repeated headings of a page distinct anchors by numbering
+// the later ones, like "example", "example-2". Numbers skip anchors other
+// headings have, so "Example 2" and a second "Example" don't share one.
+type Anchors map[string]int
+
+// Next returns the anchor for the next heading of the page
+func (a Anchors) Next(heading string) string {
+	anchor := Anchor(heading)
+	if a[anchor] == 0 {
+		a[anchor] = 1
+		return anchor
+	}
+	for n := a[anchor] + 1; ; n++ {
+		numbered := anchor + "-" + strconv.Itoa(n)
+		if a[numbered] == 0 {
+			a[anchor] = n
+			a[numbered] = 1
+			return numbered
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package search
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnchor(t *testing.T) {
+	tests := []struct {
+		heading string
+		want    string
+	}{
+		{"Installation", "installation"},
+		{"Hooks & UseState", "hooks-usestate"},
+		{"Step 1: Create a Project", "step-1-create-a-project"},
+
+		// Runs of punctuation and spaces become one dash, none at the ends
+		{"Grid -- Layout", "grid-layout"},
+		{"  Rows, Columns & Grids  ", "rows-columns-grids"},
+		{"(Optional) Docker", "optional-docker"},
+		{"What is gofred?", "what-is-gofred"},
+		{"row.New()", "row-new"},
+		{"Go 1.25+", "go-1-25"},
+
+		// Apostrophes join words
+		{"What's Next", "whats-next"},
+		{"Don’t Repeat Yourself", "dont-repeat-yourself"},
+
+		// Only ASCII letters and digits are kept
+		{"Größe", "gr-e"},
+		{"日本語", "section"},
+		{"", "section"},
+		{"---", "section"},
+	}
+	for _, tt := range tests {
+		if got := Anchor(tt.heading); got != tt.want {
+			t.Errorf("Anchor(%q) = %q, want %q", tt.heading, got, tt.want)
+		}
+	}
+}
+
+func TestAnchors(t *testing.T) {
+	anchors := Anchors{}
+	var got []string
+	for _, heading := range []string{"Example", "Usage", "Example", "example!", "Example 2", "Example 3", "Example"} {
+		got = append(got, anchors.Next(heading))
+	}
+
+	// Headings whose anchor was taken by a numbered one get numbered too
+	want := []string{"example", "usage", "example-2", "example-3", "example-2-2", "example-3-2", "example-4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("anchors = %q, want %q", got, want)
+	}
+
+	// Every page starts over
+	if got := (Anchors{}).Next("Example"); got != "example" {
+		t.Errorf("anchor on a new page = %q, want example", got)
+	}
+}
+
+func TestOutline(t *testing.T) {
+	defer func(saved []Document) { documents = saved }(documents)
+	documents = []Document{
+		{Href: "/docs/a", Page: "A", Text: "Intro"},
+		{Href: "/docs/a", Page: "A", Section: "First", Anchor: "first", Level: 2},
+		{Href: "/docs/b", Page: "B", Section: "Other", Anchor: "other", Level: 2},
+		{Href: "/docs/a", Page: "A", Section: "Second", Anchor: "second", Level: 3},
+	}
+
+	var got []string
+	for _, doc := range Outline("/docs/a") {
+		got = append(got, doc.Anchor)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Outline = %q, want %q", got, want)
+	}
+	if got := Outline("/docs/missing"); got != nil {
+		t.Errorf("Outline of a missing page = %+v", got)
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	defer func(saved []Document) { documents = saved }(documents)
+	words := func(n int) string {
+		return strings.TrimSpace(strings.Repeat("word ", n))
+	}
+	documents = []Document{
+		{Href: "/docs/short", Text: words(10)},
+		{Href: "/docs/exact", Text: words(150), Code: words(50)},
+		{Href: "/docs/long", Text: words(200)},
+		{Href: "/docs/long", Section: "More", Text: words(100), Code: "fmt.Println(1)\n\treturn"},
+		{Href: "/docs/empty"},
+	}
+
+	for href, want := range map[string]int{
+		"/docs/short":   1,
+		"/docs/exact":   1,
+		"/docs/long":    2,
+		"/docs/empty":   0,
+		"/docs/missing": 0,
+	} {
+		if got := ReadingTime(href); got != want {
+			t.Errorf("ReadingTime(%q) = %d, want %d", href, got, want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Package search looks up docs pages in an index generated at build time.
+//
+// The index is written by cmd/searchindex from the docs registry: every
+// heading of every page becomes a document holding the prose and code
+// samples that follow it.
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:generate go run github.com/gofred-io/gofred-website/cmd/searchindex
+
+const (
+	snippetLength = 120
+)
+
+// Document is a searchable section of a docs page
+type Document struct {
+	Href    string
+	Page    string
+	Section string
+	Text    string
+	Code    string
+
+	// Anchor is the fragment ID of the heading on its page
+	Anchor string
+
+	// Level is 2 for sections and 3 for subsections, 0 for the page intro
+	Level int
+}
+
+// Fragment is a run of text that either matches the query or not
+type Fragment struct {
+	Text  string
+	Match bool
+}
+
+// Result is a document matching a query, with its title and snippet split
+// into fragments so the matches can be highlighted
+type Result struct {
+	Document Document
+	Score    int
+	Title    []Fragment
+	Snippet  []Fragment
+}
+
+// Title returns the section heading, or the page title for the page intro
+func (d Document) Title() string {
+	if d.Section == "" {
+		return d.Page
+	}
+	return d.Section
+}
+
+// Search returns up to limit documents of the generated index that contain
+// every word of the query, best matches first
+func Search(query string, limit int) []Result {
+	return Find(documents, query, limit)
+}
+
+// Find is Search over an arbitrary set of documents
+func Find(docs []Document, query string, limit int) []Result {
+	terms := strings.Fields(lower(query))
+	if len(terms) == 0 {
+		return nil
+	}
+	phrase := strings.Join(terms, " ")
+
+	var results []Result
+	for _, doc := range docs {
+		score, ok := scoreDocument(doc, terms, phrase)
+		if !ok {
+			continue
+		}
+		results = append(results, Result{
+			Document: doc,
+			Score:    score,
+			Title:    Highlight(doc.Title(), terms),
+			Snippet:  Highlight(snippet(doc, terms), terms),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// scoreDocument weighs matches in titles above matches in code, and code
+// above prose, so a query for an API name finds the section that shows it
+func scoreDocument(doc Document, terms []string, phrase string) (int, bool) {
+	page, section, text, code := lower(doc.Page), lower(doc.Section), lower(doc.Text), lower(doc.Code)
+
+	score := 0
+	for _, term := range terms {
+		termScore := 0
+		if strings.Contains(page, term) {
+			termScore += 8
+		}
+		if strings.Contains(section, term) {
+			termScore += 6
+			if hasWordPrefix(section, term) {
+				termScore += 2
+			}
+		}
+		termScore += 4 * min(strings.Count(code, term), 3)
+		termScore += 2 * min(strings.Count(text, term), 3)
+
+		if termScore == 0 {
+			return 0, false
+		}
+		score += termScore
+	}
+
+	if len(terms) > 1 {
+		switch {
+		case strings.Contains(section, phrase), strings.Contains(page, phrase):
+			score += 10
+		case strings.Contains(code, phrase), strings.Contains(text, phrase):
+			score += 5
+		}
+	}
+
+	// The page intro is a better hit than any one of its sections for a page title match
+	if doc.Section == "" && strings.Contains(page, phrase) {
+		score += 10
+	}
+
+	return score, true
+}
+
+// snippet returns a window of the prose, or failing that the code, around the first match
+func snippet(doc Document, terms []string) string {
+	for _, source := range []string{doc.Text, doc.Code} {
+		source = strings.Join(strings.Fields(source), " ")
+		lowered := lower(source)
+		for _, term := range terms {
+			if index := strings.Index(lowered, term); index >= 0 {
+				return window(source, index, len(term))
+			}
+		}
+	}
+	return window(strings.Join(strings.Fields(doc.Text), " "), 0, 0)
+}
+
+// window cuts about snippetLength bytes of source around a match, on word boundaries
+func window(source string, index, length int) string {
+	if len(source) <= snippetLength {
+		return source
+	}
+	if index > len(source) {
+		index = 0
+	}
+
+	start := max(index-(snippetLength-length)/2, 0)
+	end := min(start+snippetLength, len(source))
+	start = max(end-snippetLength, 0)
+
+	if start > 0 {
+		if space := strings.IndexByte(source[start:], ' '); space >= 0 && start+space < index {
+			start += space + 1
+		}
+	}
+	if end < len(source) {
+		if space := strings.LastIndexByte(source[:end], ' '); space > index+length {
+			end = space
+		}
+	}
+
+	for start > 0 && !utf8.RuneStart(source[start]) {
+		start--
+	}
+	for end < len(source) && !utf8.RuneStart(source[end]) {
+		end++
+	}
+
+	result := source[start:end]
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(source) {
+		result += "…"
+	}
+	return result
+}
+
+// Highlight splits value into fragments, marking every occurrence of a term
+func Highlight(value string, terms []string) []Fragment {
+	lowered := lower(value)
+	matched := make([]bool, len(value))
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+		for offset := 0; ; {
+			index := strings.Index(lowered[offset:], term)
+			if index < 0 {
+				break
+			}
+			for i := offset + index; i < offset+index+len(term); i++ {
+				matched[i] = true
+			}
+			offset += index + len(term)
+		}
+	}
+
+	return fragments(value, matched)
+}
+
+func hasWordPrefix(value, term string) bool {
+	for _, word := range strings.FieldsFunc(value, isSeparator) {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeparator(r rune) bool {
+	return r == ' ' || r == '.' || r == '(' || r == ')' || r == '-' || r == '/' || r == '&'
+}
+
+// lower folds ASCII letters only, so byte offsets stay valid for highlighting
+func lower(value string) string {
+	var builder strings.Builder
+	builder.Grow(len(value))
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		builder.WriteByte(c)
+	}
+	return builder.String()
+}
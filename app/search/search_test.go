@@ -0,0 +1,171 @@
+package search
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var testDocs = []Document{
+	{Href: "/docs/buttons#click-handlers", Page: "Buttons", Section: "Click handlers", Text: "Call OnClick to handle a click."},
+	{Href: "/docs/events", Page: "Events", Text: "Events like click and input.", Code: "button.OnClick(handler)"},
+	{Href: "/docs/click", Page: "Click", Text: "All about clicks"},
+	{Href: "/docs/layout", Page: "Layout", Text: "Rows and columns"},
+}
+
+func hrefs(results []Result) []string {
+	var list []string
+	for _, result := range results {
+		list = append(list, result.Document.Href)
+	}
+	return list
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		query string
+		limit int
+		want  []string
+	}{
+		// The page title beats the section heading, and both beat code and prose
+		{"click", 0, []string{"/docs/click", "/docs/buttons#click-handlers", "/docs/events"}},
+		{"CLICK", 0, []string{"/docs/click", "/docs/buttons#click-handlers", "/docs/events"}},
+		{"click", 2, []string{"/docs/click", "/docs/buttons#click-handlers"}},
+
+		// Every word has to match, and the phrase in a heading ranks first
+		{"click handler", 0, []string{"/docs/buttons#click-handlers", "/docs/events"}},
+		{"  click \t handler ", 0, []string{"/docs/buttons#click-handlers", "/docs/events"}},
+		{"click columns", 0, nil},
+
+		{"", 0, nil},
+		{" \t\n", 0, nil},
+		{"nothing", 0, nil},
+	}
+	for _, tt := range tests {
+		if got := hrefs(Find(testDocs, tt.query, tt.limit)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Find(%q, %d) = %q, want %q", tt.query, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestFindScores(t *testing.T) {
+	results := Find(testDocs, "click", 0)
+	for i := 1; i < len(results); i++ {
+		if results[i].Score > results[i-1].Score {
+			t.Errorf("%s scored %d ranks below %s with %d", results[i].Document.Href, results[i].Score, results[i-1].Document.Href, results[i-1].Score)
+		}
+	}
+
+	// Equal scores keep the order of the index
+	docs := []Document{{Href: "/a", Page: "A", Text: "same words"}, {Href: "/b", Page: "B", Text: "same words"}}
+	if got := hrefs(Find(docs, "words", 0)); !reflect.DeepEqual(got, []string{"/a", "/b"}) {
+		t.Errorf("ties ordered %q, want the index order", got)
+	}
+}
+
+func TestFindFragments(t *testing.T) {
+	results := Find(testDocs, "handler", 0)
+	if len(results) != 2 {
+		t.Fatalf("Find returned %d results, want 2", len(results))
+	}
+	want := []Fragment{{Text: "Click "}, {Text: "handler", Match: true}, {Text: "s"}}
+	if got := results[0].Title; !reflect.DeepEqual(got, want) {
+		t.Errorf("title = %+v, want %+v", got, want)
+	}
+
+	// Without a match in the prose the snippet comes from the code
+	want = []Fragment{{Text: "button.OnClick("}, {Text: "handler", Match: true}, {Text: ")"}}
+	if got := results[1].Snippet; !reflect.DeepEqual(got, want) {
+		t.Errorf("snippet = %+v, want %+v", got, want)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	var words []string
+	for i := range 60 {
+		words = append(words, "word"+strconv.Itoa(i))
+	}
+	long := strings.Join(words[:30], " ") + " match " + strings.Join(words[30:], " ")
+	umlauts := strings.Repeat("ä", 100) + " match " + strings.Repeat("ö", 100)
+	kanji := strings.Repeat("日本", 50) + "match" + strings.Repeat("語", 50)
+
+	tests := []struct {
+		name          string
+		source        string
+		index, length int
+	}{
+		{"short", "short text", 6, 4},
+		{"words", long, strings.Index(long, "match"), 5},
+		{"start", long, 0, 4},
+		{"end", long, len(long) - 4, 4},
+		{"two byte runes", umlauts, strings.Index(umlauts, "match"), 5},
+		{"three byte runes without spaces", kanji, strings.Index(kanji, "match"), 5},
+		{"index past the end", long, len(long) + 10, 5},
+	}
+	for _, tt := range tests {
+		got := window(tt.source, tt.index, tt.length)
+		if !utf8.ValidString(got) {
+			t.Errorf("%s: window cut a rune: %q", tt.name, got)
+		}
+		text := strings.TrimSuffix(strings.TrimPrefix(got, "…"), "…")
+		if !strings.Contains(tt.source, text) {
+			t.Errorf("%s: %q is not part of the source", tt.name, got)
+		}
+		if len(text) > snippetLength+utf8.UTFMax {
+			t.Errorf("%s: window of %d bytes, want about %d", tt.name, len(text), snippetLength)
+		}
+		if tt.index < len(tt.source) && !strings.Contains(got, tt.source[tt.index:tt.index+tt.length]) {
+			t.Errorf("%s: %q leaves out the match", tt.name, got)
+		}
+		if hasStart := !strings.HasPrefix(tt.source, text); hasStart != strings.HasPrefix(got, "…") {
+			t.Errorf("%s: %q and its leading ellipsis disagree", tt.name, got)
+		}
+		if hasEnd := !strings.HasSuffix(tt.source, text); hasEnd != strings.HasSuffix(got, "…") {
+			t.Errorf("%s: %q and its trailing ellipsis disagree", tt.name, got)
+		}
+	}
+
+	// Cuts fall on word boundaries
+	got := window(long, strings.Index(long, "match"), 5)
+	for _, word := range strings.Fields(strings.Trim(got, "…")) {
+		if !strings.HasPrefix(word, "word") && word != "match" || !strings.Contains(long, " "+word+" ") {
+			t.Errorf("window cut a word into %q", word)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		value string
+		terms []string
+		want  []Fragment
+	}{
+		{"Quick Start", []string{"start"}, []Fragment{{Text: "Quick "}, {Text: "Start", Match: true}}},
+		{"go gofred go", []string{"go"}, []Fragment{
+			{Text: "go", Match: true}, {Text: " "}, {Text: "go", Match: true}, {Text: "fred "}, {Text: "go", Match: true},
+		}},
+
+		// Overlapping and adjacent matches merge into one fragment
+		{"abcd", []string{"ab", "bc"}, []Fragment{{Text: "abc", Match: true}, {Text: "d"}}},
+		{"gofred", []string{"gofred", "fred"}, []Fragment{{Text: "gofred", Match: true}}},
+		{"aaaa", []string{"aa"}, []Fragment{{Text: "aaaa", Match: true}}},
+		{"xaaay", []string{"aa"}, []Fragment{{Text: "x"}, {Text: "aa", Match: true}, {Text: "ay"}}},
+
+		// Offsets stay on rune boundaries around multi-byte text
+		{"Größe gross", []string{"gr"}, []Fragment{
+			{Text: "Gr", Match: true}, {Text: "öße "}, {Text: "gr", Match: true}, {Text: "oss"},
+		}},
+		{"日本 go", []string{"go"}, []Fragment{{Text: "日本 "}, {Text: "go", Match: true}}},
+
+		{"no match", []string{"zzz"}, []Fragment{{Text: "no match"}}},
+		{"empty term", []string{""}, []Fragment{{Text: "empty term"}}},
+		{"", []string{"a"}, nil},
+	}
+	for _, tt := range tests {
+		if got := Highlight(tt.value, tt.terms); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Highlight(%q, %q) = %+v, want %+v", tt.value, tt.terms, got, tt.want)
+		}
+	}
+}
@@ -108,6 +108,52 @@ var (
 			},
 		},
 	}
+
+	darkTokens = Tokens{
+		Background:   "#212A32",
+		OnBackground: "#E0E4E4",
+		Mark:         "#92400E",
+		Scrim:        "#000000A6",
+
+		Primary:     "#1976D2",
+		OnPrimary:   "#FFFFFF",
+		OnSecondary: "#1F2937",
+		OnTertiary:  "#9CA3AF",
+
+		Accent:        "#4FB3DB",
+		AccentSurface: "#1E3A4C",
+		AccentBorder:  "#2B5A73",
+		Highlight:     "#A78BFA",
+
+		Success:        "#10B981",
+		SuccessText:    "#6EE7B7",
+		SuccessSurface: "#064E3B",
+		Error:          "#EF4444",
+		Warning:        "#F59E0B",
+		Info:           "#3B82F6",
+		Neutral:        "#D1D5DB",
+		OnStatus:       "#111827",
+
+		Muted:         "#9CA3AF",
+		Subtle:        "#6B7280",
+		Surface:       "#26313A",
+		SurfaceBorder: "#374151",
+	}
+
+	darkSyntax = Syntax{
+		Keyword:  "#FF7B72",
+		String:   "#A5D6FF",
+		Number:   "#79C0FF",
+		Comment:  "#8B949E",
+		Type:     "#FFA657",
+		Function: "#D2A8FF",
+		Builtin:  "#79C0FF",
+		Variable: "#FFA657",
+		Key:      "#7EE787",
+
+		Added:   "#7EE787",
+		Removed: "#FF7B72",
+	}
 )
 
 func DarkTheme() *theme_data.ThemeData {
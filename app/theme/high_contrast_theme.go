@@ -0,0 +1,162 @@
+package theme
+
+import (
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+	"github.com/gofred-io/gofred/theme/color"
+	td "github.com/gofred-io/gofred/theme/theme_data"
+	style "github.com/gofred-io/gofred/theme/theme_style"
+)
+
+var (
+	highContrastTheme = &td.ThemeData{
+		Name: string(ThemeHighContrast),
+		BoxTheme: td.BoxTheme{
+			CodeBlockStyle: style.ContainerStyleCollection{
+				Primary: style.ContainerStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x000000ff)),
+				},
+			},
+			ContainerStyle: style.ContainerStyleCollection{
+				Primary: style.ContainerStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x000000ff)),
+					BorderColor:     style.ThemeValue(color.From(0xffffffff)),
+				},
+				Secondary: style.ContainerStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x0a0a0aff)),
+					BorderColor:     style.ThemeValue(color.From(0xffffffff)),
+				},
+				Tertiary: style.ContainerStyle{
+					BackgroundColor: style.ThemeValue(color.From(0xffd600ff)),
+					BorderColor:     style.ThemeValue(color.From(0xffd600ff)),
+				},
+			},
+		},
+		ButtonTheme: td.ButtonTheme{
+			ButtonStyle: style.ButtonStyleCollection{
+				Primary: style.ButtonStyle{
+					BackgroundColor: style.ThemeValue(color.From(0xffd600ff)),
+					BorderColor:     style.ThemeValue(color.From(0xffffffff)),
+					BorderRadius:    style.ThemeValue(8),
+					BorderWidth:     style.ThemeValue(spacing.All(1)),
+					BorderStyle:     style.ThemeValue(theme.BorderStyleTypeSolid),
+					TextStyle: style.TextStyle{
+						FontSize:   style.ThemeValue(16),
+						FontWeight: style.ThemeValue("400"),
+						Color:      style.ThemeValue(color.From(0x000000ff)),
+						FontFamily: style.ThemeValue("Ubuntu"),
+					},
+				},
+				Secondary: style.ButtonStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x000000ff)),
+					BorderColor:     style.ThemeValue(color.From(0xffffffff)),
+					BorderWidth:     style.ThemeValue(spacing.All(1)),
+					BorderStyle:     style.ThemeValue(theme.BorderStyleTypeSolid),
+					BorderRadius:    style.ThemeValue(8),
+					TextStyle: style.TextStyle{
+						FontSize:   style.ThemeValue(16),
+						FontWeight: style.ThemeValue("400"),
+						Color:      style.ThemeValue(color.From(0xffffffff)),
+						FontFamily: style.ThemeValue("Ubuntu"),
+					},
+				},
+			},
+			IconButtonStyle: style.ButtonStyleCollection{
+				Primary: style.ButtonStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x00000000)),
+					BorderColor:     style.ThemeValue(color.From(0x00000000)),
+					BorderRadius:    style.ThemeValue(8),
+					Fill:            style.ThemeValue(color.From(0xffffffff)),
+				},
+				Secondary: style.ButtonStyle{
+					BackgroundColor: style.ThemeValue(color.From(0x00000000)),
+					BorderColor:     style.ThemeValue(color.From(0x00000000)),
+					BorderRadius:    style.ThemeValue(8),
+					Fill:            style.ThemeValue(color.From(0xffffffff)),
+				},
+			},
+		},
+		TextTheme: td.TextTheme{
+			CodeBlockStyle: style.TextStyleCollection{
+				Primary: style.TextStyle{
+					FontSize:   style.ThemeValue(14),
+					FontWeight: style.ThemeValue("400"),
+					Color:      style.ThemeValue(color.From(0xffffffff)),
+					FontFamily: style.ThemeValue("ui-monospace, SFMono-Regular, Menlo, Monaco, Consolas, \"Liberation Mono\", \"Courier New\", monospace"),
+				},
+			},
+			TextStyle: style.TextStyleCollection{
+				Primary: style.TextStyle{
+					FontSize:   style.ThemeValue(16),
+					FontWeight: style.ThemeValue("400"),
+					Color:      style.ThemeValue(color.From(0xffffffff)),
+					FontFamily: style.ThemeValue("Ubuntu"),
+				},
+				Secondary: style.TextStyle{
+					FontSize:   style.ThemeValue(16),
+					FontWeight: style.ThemeValue("400"),
+					Color:      style.ThemeValue(color.From(0xe6e6e6ff)),
+					FontFamily: style.ThemeValue("Ubuntu"),
+				},
+				Tertiary: style.TextStyle{
+					FontSize:   style.ThemeValue(16),
+					FontWeight: style.ThemeValue("400"),
+					Color:      style.ThemeValue(color.From(0x000000ff)),
+					FontFamily: style.ThemeValue("Ubuntu"),
+				},
+			},
+		},
+	}
+
+	highContrastTokens = Tokens{
+		Background:   "#000000",
+		OnBackground: "#FFFFFF",
+		Mark:         "#665200",
+		Scrim:        "#000000D9",
+
+		Primary:     "#FFD600",
+		OnPrimary:   "#000000",
+		OnSecondary: "#FFFFFF",
+		OnTertiary:  "#000000",
+
+		Accent:        "#FFD600",
+		AccentSurface: "#000000",
+		AccentBorder:  "#FFFFFF",
+		Highlight:     "#D0A6FF",
+
+		Success:        "#00E676",
+		SuccessText:    "#00E676",
+		SuccessSurface: "#000000",
+		Error:          "#FF6E6E",
+		Warning:        "#FFD600",
+		Info:           "#6CB4FF",
+		Neutral:        "#E6E6E6",
+		OnStatus:       "#000000",
+
+		Muted:         "#E6E6E6",
+		Subtle:        "#BDBDBD",
+		Surface:       "#0A0A0A",
+		SurfaceBorder: "#FFFFFF",
+	}
+
+	highContrastSyntax = Syntax{
+		Keyword:  "#FFD600",
+		String:   "#00E676",
+		Number:   "#6CB4FF",
+		Comment:  "#BDBDBD",
+		Type:     "#FF9E80",
+		Function: "#82B1FF",
+		Builtin:  "#6CB4FF",
+		Variable: "#FF9E80",
+		Key:      "#00E5FF",
+
+		Added:   "#00E676",
+		Removed: "#FF6E6E",
+	}
+)
+
+// HighContrastTheme is white text on black with yellow highlights, for low
+// vision and bright surroundings
+func HighContrastTheme() *td.ThemeData {
+	return highContrastTheme
+}
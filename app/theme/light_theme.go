@@ -107,6 +107,52 @@ var (
 			},
 		},
 	}
+
+	lightTokens = Tokens{
+		Background:   "#FFFFFF",
+		OnBackground: "#1F2937",
+		Mark:         "#FDE68A",
+		Scrim:        "#00000080",
+
+		Primary:     "#1976D2",
+		OnPrimary:   "#FFFFFF",
+		OnSecondary: "#374151",
+		OnTertiary:  "#9CA3AF",
+
+		Accent:        "#2B799B",
+		AccentSurface: "#EFF6FF",
+		AccentBorder:  "#DBEAFE",
+		Highlight:     "#8B5CF6",
+
+		Success:        "#047857",
+		SuccessText:    "#047857",
+		SuccessSurface: "#ECFDF5",
+		Error:          "#DC2626",
+		Warning:        "#B45309",
+		Info:           "#2563EB",
+		Neutral:        "#424242",
+		OnStatus:       "#FFFFFF",
+
+		Muted:         "#6B7280",
+		Subtle:        "#9CA3AF",
+		Surface:       "#F9FAFB",
+		SurfaceBorder: "#E5E7EB",
+	}
+
+	lightSyntax = Syntax{
+		Keyword:  "#FF7B72",
+		String:   "#A5D6FF",
+		Number:   "#79C0FF",
+		Comment:  "#9CA3AF",
+		Type:     "#FFA657",
+		Function: "#D2A8FF",
+		Builtin:  "#79C0FF",
+		Variable: "#FFA657",
+		Key:      "#7EE787",
+
+		Added:   "#7EE787",
+		Removed: "#FF7B72",
+	}
 )
 
 func LightTheme() *td.ThemeData {
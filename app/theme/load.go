@@ -0,0 +1,215 @@
+package theme
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/gofred-io/gofred-website/app/theme/themefile"
+
+	"github.com/gofred-io/gofred/options/spacing"
+	"github.com/gofred-io/gofred/theme"
+	"github.com/gofred-io/gofred/theme/color"
+	style "github.com/gofred-io/gofred/theme/theme_style"
+)
+
+// themeFiles are the themes shipped as files, registered after the built-in
+// ones. make check validates them with cmd/themecheck.
+//
+//go:embed themes
+var themeFiles embed.FS
+
+// registerFiles registers every file of themes/ in name order
+func registerFiles() {
+	entries, err := fs.ReadDir(themeFiles, "themes")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		data, err := themeFiles.ReadFile("themes/" + entry.Name())
+		if err != nil {
+			panic(err)
+		}
+		info, err := Load(entry.Name(), data)
+		if err != nil {
+			panic(err)
+		}
+		Register(info)
+	}
+}
+
+// Load reads a JSON or YAML theme file, see package themefile for the
+// format. Styles and colours the file leaves out are copied from the light
+// theme, or the dark theme for dark themes. The theme is not registered.
+func Load(name string, data []byte) (Info, error) {
+	file, err := themefile.Parse(name, data)
+	if err != nil {
+		return Info{}, err
+	}
+
+	base, tokens, syntax := lightTheme, lightTokens, lightSyntax
+	if file.Dark {
+		base, tokens, syntax = darkTheme, darkTokens, darkSyntax
+	}
+	themeData := *base
+	themeData.Name = file.Name
+
+	if box := file.BoxTheme; box != nil {
+		containerStyles(&themeData.BoxTheme.CodeBlockStyle, box.CodeBlockStyle)
+		containerStyles(&themeData.BoxTheme.ContainerStyle, box.ContainerStyle)
+	}
+	if button := file.ButtonTheme; button != nil {
+		buttonStyles(&themeData.ButtonTheme.ButtonStyle, button.ButtonStyle)
+		buttonStyles(&themeData.ButtonTheme.IconButtonStyle, button.IconButtonStyle)
+	}
+	if text := file.TextTheme; text != nil {
+		textStyles(&themeData.TextTheme.CodeBlockStyle, text.CodeBlockStyle)
+		textStyles(&themeData.TextTheme.TextStyle, text.TextStyle)
+	}
+
+	tokenColors(&tokens, file.Tokens)
+	syntaxColors(&syntax, file.Syntax)
+
+	return Info{Name: Theme(file.Name), Title: file.Title, Dark: file.Dark, Data: &themeData, Tokens: tokens, Syntax: syntax}, nil
+}
+
+func tokenColors(target *Tokens, from *themefile.Tokens) {
+	if from == nil {
+		return
+	}
+	token(&target.Background, from.Background)
+	token(&target.OnBackground, from.OnBackground)
+	token(&target.Mark, from.Mark)
+	token(&target.Scrim, from.Scrim)
+	token(&target.Primary, from.Primary)
+	token(&target.OnPrimary, from.OnPrimary)
+	token(&target.OnSecondary, from.OnSecondary)
+	token(&target.OnTertiary, from.OnTertiary)
+	token(&target.Accent, from.Accent)
+	token(&target.AccentSurface, from.AccentSurface)
+	token(&target.AccentBorder, from.AccentBorder)
+	token(&target.Highlight, from.Highlight)
+	token(&target.Success, from.Success)
+	token(&target.SuccessText, from.SuccessText)
+	token(&target.SuccessSurface, from.SuccessSurface)
+	token(&target.Error, from.Error)
+	token(&target.Warning, from.Warning)
+	token(&target.Info, from.Info)
+	token(&target.Neutral, from.Neutral)
+	token(&target.OnStatus, from.OnStatus)
+	token(&target.Muted, from.Muted)
+	token(&target.Subtle, from.Subtle)
+	token(&target.Surface, from.Surface)
+	token(&target.SurfaceBorder, from.SurfaceBorder)
+}
+
+func syntaxColors(target *Syntax, from *themefile.Syntax) {
+	if from == nil {
+		return
+	}
+	token(&target.Keyword, from.Keyword)
+	token(&target.String, from.String)
+	token(&target.Number, from.Number)
+	token(&target.Comment, from.Comment)
+	token(&target.Type, from.Type)
+	token(&target.Function, from.Function)
+	token(&target.Builtin, from.Builtin)
+	token(&target.Variable, from.Variable)
+	token(&target.Key, from.Key)
+	token(&target.Added, from.Added)
+	token(&target.Removed, from.Removed)
+}
+
+func token(target *string, from *themefile.Color) {
+	if from != nil {
+		*target = from.String()
+	}
+}
+
+func containerStyles(target *style.ContainerStyleCollection, from *themefile.ContainerStyleCollection) {
+	if from == nil {
+		return
+	}
+	containerStyle(&target.Primary, from.Primary)
+	containerStyle(&target.Secondary, from.Secondary)
+	containerStyle(&target.Tertiary, from.Tertiary)
+}
+
+func containerStyle(target *style.ContainerStyle, from *themefile.ContainerStyle) {
+	if from == nil {
+		return
+	}
+	if from.BackgroundColor != nil {
+		target.BackgroundColor = style.ThemeValue(fromHex(color.From, *from.BackgroundColor))
+	}
+	if from.BorderColor != nil {
+		target.BorderColor = style.ThemeValue(fromHex(color.From, *from.BorderColor))
+	}
+}
+
+func buttonStyles(target *style.ButtonStyleCollection, from *themefile.ButtonStyleCollection) {
+	if from == nil {
+		return
+	}
+	buttonStyle(&target.Primary, from.Primary)
+	buttonStyle(&target.Secondary, from.Secondary)
+}
+
+func buttonStyle(target *style.ButtonStyle, from *themefile.ButtonStyle) {
+	if from == nil {
+		return
+	}
+	if from.BackgroundColor != nil {
+		target.BackgroundColor = style.ThemeValue(fromHex(color.From, *from.BackgroundColor))
+	}
+	if from.BorderColor != nil {
+		target.BorderColor = style.ThemeValue(fromHex(color.From, *from.BorderColor))
+	}
+	if from.BorderRadius != nil {
+		target.BorderRadius = style.ThemeValue(*from.BorderRadius)
+	}
+	if from.BorderWidth != nil {
+		target.BorderWidth = style.ThemeValue(spacing.All(*from.BorderWidth))
+	}
+	if from.BorderStyle != nil {
+		// "solid" is the only style themefile accepts
+		target.BorderStyle = style.ThemeValue(theme.BorderStyleTypeSolid)
+	}
+	if from.Fill != nil {
+		target.Fill = style.ThemeValue(fromHex(color.From, *from.Fill))
+	}
+	textStyle(&target.TextStyle, from.TextStyle)
+}
+
+func textStyles(target *style.TextStyleCollection, from *themefile.TextStyleCollection) {
+	if from == nil {
+		return
+	}
+	textStyle(&target.Primary, from.Primary)
+	textStyle(&target.Secondary, from.Secondary)
+	textStyle(&target.Tertiary, from.Tertiary)
+}
+
+func textStyle(target *style.TextStyle, from *themefile.TextStyle) {
+	if from == nil {
+		return
+	}
+	if from.FontSize != nil {
+		target.FontSize = style.ThemeValue(*from.FontSize)
+	}
+	if from.FontWeight != nil {
+		target.FontWeight = style.ThemeValue(*from.FontWeight)
+	}
+	if from.Color != nil {
+		target.Color = style.ThemeValue(fromHex(color.From, *from.Color))
+	}
+	if from.FontFamily != nil {
+		target.FontFamily = style.ThemeValue(*from.FontFamily)
+	}
+}
+
+// fromHex is color.From for a parsed colour. It converts the colour to the
+// integer type of from's parameter, which the hex literals of the built-in
+// themes leave untyped.
+func fromHex[T ~int | ~int64 | ~uint | ~uint32 | ~uint64, C any](from func(T) C, c themefile.Color) C {
+	return from(T(c))
+}
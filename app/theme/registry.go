@@ -0,0 +1,72 @@
+package theme
+
+import (
+	"github.com/gofred-io/gofred/theme/theme_data"
+)
+
+// Info is a theme of the registry
+type Info struct {
+	Name  Theme
+	Title string
+
+	// Dark marks themes with light text on dark backgrounds. The CSS outside
+	// the widgets follows it through html[data-scheme="dark"].
+	Dark bool
+
+	Data *theme_data.ThemeData
+
+	// Tokens are the theme's semantic colours and Syntax the colours of
+	// highlighted code. Themes registered without them get the ones of the
+	// light or dark theme.
+	Tokens Tokens
+	Syntax Syntax
+}
+
+var (
+	themes []Info
+)
+
+// Register adds a theme to the theme picker, in registration order. The
+// theme data gets the theme's name. Registering a name twice panics.
+//
+// Themes registered after the app started, like from the init function of
+// another package, are applied right away if the user had picked them on a
+// previous visit.
+func Register(info Info) {
+	if _, ok := Lookup(info.Name); ok {
+		panic("theme: " + string(info.Name) + " registered twice")
+	}
+	info.Data.Name = string(info.Name)
+	if info.Tokens == (Tokens{}) {
+		info.Tokens = lightTokens
+		if info.Dark {
+			info.Tokens = darkTokens
+		}
+	}
+	if info.Syntax == (Syntax{}) {
+		info.Syntax = lightSyntax
+		if info.Dark {
+			info.Syntax = darkSyntax
+		}
+	}
+	themes = append(themes, info)
+
+	if Preference(info.Name) == CurrentPreference() {
+		apply()
+	}
+}
+
+// Lookup returns the theme registered with the given name
+func Lookup(name Theme) (Info, bool) {
+	for _, info := range themes {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return Info{}, false
+}
+
+// Themes returns every registered theme in registration order
+func Themes() []Info {
+	return append([]Info(nil), themes...)
+}
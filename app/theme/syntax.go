@@ -0,0 +1,62 @@
+package theme
+
+import (
+	"github.com/gofred-io/gofred-website/app/browser"
+	"github.com/gofred-io/gofred-website/app/highlight"
+)
+
+// Syntax are the colours of highlighted code, by token kind of package
+// highlight, as "#RRGGBB" strings. They are drawn on the code block style's
+// background, which is dark in every built-in theme. Added and Removed mark
+// the lines of diffs.
+type Syntax struct {
+	Keyword  string
+	String   string
+	Number   string
+	Comment  string
+	Type     string
+	Function string
+	Builtin  string
+	Variable string
+	Key      string
+
+	Added   string
+	Removed string
+}
+
+// Color returns the colour of kind, "" for plain text which keeps the code
+// block's text colour
+func (s Syntax) Color(kind highlight.Kind) string {
+	switch kind {
+	case highlight.Keyword:
+		return s.Keyword
+	case highlight.String:
+		return s.String
+	case highlight.Number:
+		return s.Number
+	case highlight.Comment:
+		return s.Comment
+	case highlight.Type:
+		return s.Type
+	case highlight.Function:
+		return s.Function
+	case highlight.Builtin:
+		return s.Builtin
+	case highlight.Variable:
+		return s.Variable
+	case highlight.Key:
+		return s.Key
+	}
+	return ""
+}
+
+// applySyntax sets the colours as the --syntax-<kind> custom properties the
+// .syntax-<kind> rules of web/index.css use, so highlighted code follows
+// theme changes without being highlighted again
+func applySyntax(s Syntax) {
+	for _, kind := range highlight.Kinds() {
+		browser.SetDocumentStyle("--syntax-"+kind.String(), s.Color(kind))
+	}
+	browser.SetDocumentStyle("--syntax-added", s.Added)
+	browser.SetDocumentStyle("--syntax-removed", s.Removed)
+}
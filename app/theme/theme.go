@@ -1,25 +1,123 @@
 package theme
 
 import (
+	"github.com/gofred-io/gofred-website/app/browser"
+
 	"github.com/gofred-io/gofred/hooks"
+	"github.com/gofred-io/gofred/listenable"
 	"github.com/gofred-io/gofred/theme/theme_data"
 )
 
 type Theme string
 
 const (
-	ThemeLight Theme = "light"
-	ThemeDark  Theme = "dark"
+	ThemeLight        Theme = "light"
+	ThemeDark         Theme = "dark"
+	ThemeHighContrast Theme = "high-contrast"
+
+	// ThemeSepia is loaded from themes/sepia.yaml
+	ThemeSepia Theme = "sepia"
+)
+
+// Preference is the theme the user picked, the name of a registered theme
+// or PreferenceSystem
+type Preference string
+
+const (
+	// PreferenceSystem follows the light or dark setting of the operating
+	// system with ThemeLight or ThemeDark
+	PreferenceSystem Preference = "system"
+)
+
+const (
+	// storageKey and schemeKey are the localStorage keys of the preference
+	// and of whether it is dark. The inline script in web/index.html reads
+	// them to style the page before the app has loaded.
+	storageKey = "theme"
+	schemeKey  = "theme-scheme"
+
+	darkSchemeQuery = "(prefers-color-scheme: dark)"
 )
 
 var (
 	themeHook, setThemeData = hooks.UseTheme()
+
+	preference, setPreference = hooks.UseState(PreferenceSystem)
+
+	// current is the theme applied last
+	current Info
 )
 
 func init() {
-	setThemeData(lightTheme)
+	Register(Info{Name: ThemeLight, Title: "Light", Data: lightTheme, Tokens: lightTokens, Syntax: lightSyntax})
+	Register(Info{Name: ThemeDark, Title: "Dark", Dark: true, Data: darkTheme, Tokens: darkTokens, Syntax: darkSyntax})
+	Register(Info{Name: ThemeHighContrast, Title: "High contrast", Dark: true, Data: highContrastTheme, Tokens: highContrastTokens, Syntax: highContrastSyntax})
+	registerFiles()
+
+	// Unknown names are kept, the theme may be registered by a package
+	// initialised later
+	if stored := browser.Stored(storageKey); stored != "" {
+		setPreference(Preference(stored))
+	}
+	apply()
+
+	browser.OnMediaChange(darkSchemeQuery, func(bool) {
+		if CurrentPreference() == PreferenceSystem {
+			apply()
+		}
+	})
 }
 
 func Data() *theme_data.ThemeData {
 	return themeHook.ThemeData()
 }
+
+// Current returns the theme shown, which for PreferenceSystem is the light
+// or dark theme
+func Current() Info {
+	return current
+}
+
+// CurrentPreference returns the theme the user picked
+func CurrentPreference() Preference {
+	return preference.Value()
+}
+
+// PreferenceListenable notifies when the user picks another theme
+func PreferenceListenable() listenable.Listenable[Preference] {
+	return preference
+}
+
+// SetPreference switches to the theme p and remembers it for later visits
+func SetPreference(p Preference) {
+	setPreference(p)
+	browser.Store(storageKey, string(p))
+	apply()
+}
+
+// apply sets the theme data the current preference resolves to. Preferences
+// naming a theme that is not registered fall back to the system setting.
+func apply() {
+	info, ok := Lookup(Theme(CurrentPreference()))
+	if !ok {
+		name := ThemeLight
+		if browser.MatchMedia(darkSchemeQuery) {
+			name = ThemeDark
+		}
+		info, _ = Lookup(name)
+	}
+
+	scheme := "light"
+	if info.Dark {
+		scheme = "dark"
+	}
+	browser.SetDocumentData("theme", string(info.Name))
+	browser.SetDocumentData("scheme", scheme)
+	browser.Store(schemeKey, scheme)
+
+	applyTokens(info.Tokens)
+	applySyntax(info.Syntax)
+
+	current = info
+	setThemeData(info.Data)
+}
@@ -0,0 +1,191 @@
+package themefile
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// node is a value of a theme file with the line it starts on. Mappings keep
+// their keys in file order.
+type node struct {
+	line int
+
+	// scalar is a string, float64 or bool, or nil for mappings
+	scalar any
+
+	fields []field
+	isMap  bool
+}
+
+type field struct {
+	key   string
+	value *node
+}
+
+func (n *node) field(key string) *node {
+	for _, f := range n.fields {
+		if f.key == key {
+			return f.value
+		}
+	}
+	return n
+}
+
+// decoder fills the File structs from nodes, following the key tags, and
+// collects every problem instead of stopping at the first
+type decoder struct {
+	file   string
+	errors Errors
+}
+
+func (d *decoder) fail(line int, format string, args ...any) {
+	d.errors = append(d.errors, &Error{File: d.file, Line: line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// decode fills the struct target points to from the mapping n. at is the
+// dotted path of n, for messages.
+func (d *decoder) decode(n *node, target any, at string) {
+	value := reflect.ValueOf(target).Elem()
+	kind := value.Type()
+
+	if !n.isMap {
+		d.fail(n.line, "%s must be a mapping", describe(at))
+		return
+	}
+
+	keys := make(map[string]int)
+	var known []string
+	for i := 0; i < kind.NumField(); i++ {
+		key := kind.Field(i).Tag.Get("key")
+		keys[key] = i
+		known = append(known, key)
+	}
+
+	seen := make(map[string]bool)
+	for _, f := range n.fields {
+		index, ok := keys[f.key]
+		if !ok {
+			d.fail(f.value.line, "unknown key %q in %s, want one of %s", f.key, describe(at), strings.Join(known, ", "))
+			continue
+		}
+		if seen[f.key] {
+			d.fail(f.value.line, "%s is set twice", join(at, f.key))
+			continue
+		}
+		seen[f.key] = true
+		d.set(f.value, value.Field(index), kind.Field(index), join(at, f.key))
+	}
+
+	for i := 0; i < kind.NumField(); i++ {
+		if kind.Field(i).Tag.Get("required") == "true" && !seen[kind.Field(i).Tag.Get("key")] {
+			d.fail(n.line, "%s is missing %s", describe(at), kind.Field(i).Tag.Get("key"))
+		}
+	}
+}
+
+// set stores the value of n in the struct field value and reports whether
+// it was valid. Invalid values leave the field unset.
+func (d *decoder) set(n *node, value reflect.Value, structField reflect.StructField, at string) bool {
+	kind := value.Type()
+	if kind.Kind() == reflect.Pointer {
+		target := reflect.New(kind.Elem())
+		if kind.Elem().Kind() == reflect.Struct {
+			d.decode(n, target.Interface(), at)
+		} else if !d.set(n, target.Elem(), structField, at) {
+			return false
+		}
+		value.Set(target)
+		return true
+	}
+
+	if n.isMap {
+		d.fail(n.line, "%s must be a %s, not a mapping", at, typeName(kind))
+		return false
+	}
+
+	if kind == reflect.TypeOf(Color(0)) {
+		text, ok := n.scalar.(string)
+		if !ok {
+			d.fail(n.line, "%s must be a colour string like \"#1F2937\"", at)
+			return false
+		}
+		c, err := ParseColor(text)
+		if err != nil {
+			d.fail(n.line, "%s: %v", at, err)
+			return false
+		}
+		value.SetUint(uint64(c))
+		return true
+	}
+
+	switch kind.Kind() {
+	case reflect.String:
+		text, ok := n.scalar.(string)
+		if number, isNumber := n.scalar.(float64); isNumber {
+			// Numbers like fontWeight: 500 are fine as strings
+			text, ok = strconv.FormatFloat(number, 'f', -1, 64), true
+		}
+		if !ok {
+			d.fail(n.line, "%s must be a string", at)
+			return false
+		}
+		if enum := structField.Tag.Get("enum"); enum != "" && !contains(strings.Split(enum, ","), text) {
+			d.fail(n.line, "%s is %q, want one of %s", at, text, strings.ReplaceAll(enum, ",", ", "))
+			return false
+		}
+		value.SetString(text)
+	case reflect.Int:
+		number, ok := n.scalar.(float64)
+		if !ok || number != math.Trunc(number) || number < 0 {
+			d.fail(n.line, "%s must be a whole number of pixels", at)
+			return false
+		}
+		value.SetInt(int64(number))
+	case reflect.Bool:
+		flag, ok := n.scalar.(bool)
+		if !ok {
+			d.fail(n.line, "%s must be true or false", at)
+			return false
+		}
+		value.SetBool(flag)
+	}
+	return true
+}
+
+func typeName(kind reflect.Type) string {
+	switch {
+	case kind == reflect.TypeOf(Color(0)):
+		return "colour"
+	case kind.Kind() == reflect.Int:
+		return "number"
+	case kind.Kind() == reflect.Bool:
+		return "boolean"
+	}
+	return "string"
+}
+
+func describe(at string) string {
+	if at == "" {
+		return "the theme"
+	}
+	return at
+}
+
+func join(at, key string) string {
+	if at == "" {
+		return key
+	}
+	return at + "." + key
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package themefile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// parseJSON reads a JSON document into nodes, keeping the line of every
+// value
+func parseJSON(data []byte) (*node, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	p := jsonParser{data: data, decoder: decoder}
+	root, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, &Error{Line: p.line(), Msg: "unexpected content after the theme"}
+	}
+	return root, nil
+}
+
+type jsonParser struct {
+	data    []byte
+	decoder *json.Decoder
+}
+
+// line returns the line of the next token
+func (p *jsonParser) line() int {
+	offset := int(p.decoder.InputOffset())
+	rest := p.data[offset:]
+	skipped := len(rest) - len(bytes.TrimLeft(rest, " \t\r\n,:"))
+	return bytes.Count(p.data[:offset+skipped], []byte("\n")) + 1
+}
+
+func (p *jsonParser) value() (*node, error) {
+	line := p.line()
+	token, err := p.decoder.Token()
+	if err != nil {
+		return nil, p.syntaxError(err)
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		if token != '{' {
+			return nil, &Error{Line: line, Msg: "lists are not part of a theme"}
+		}
+		n := &node{line: line, isMap: true}
+		for p.decoder.More() {
+			key, err := p.decoder.Token()
+			if err != nil {
+				return nil, p.syntaxError(err)
+			}
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			n.fields = append(n.fields, field{key: key.(string), value: value})
+		}
+		if _, err := p.decoder.Token(); err != nil {
+			return nil, p.syntaxError(err)
+		}
+		return n, nil
+	case json.Number:
+		number, err := token.Float64()
+		if err != nil {
+			return nil, &Error{Line: line, Msg: err.Error()}
+		}
+		return &node{line: line, scalar: number}, nil
+	case nil:
+		return nil, &Error{Line: line, Msg: "null is not a value, leave the key out instead"}
+	default:
+		return &node{line: line, scalar: token}, nil
+	}
+}
+
+func (p *jsonParser) syntaxError(err error) error {
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) && syntax.Offset < int64(len(p.data)) {
+		return &Error{Line: bytes.Count(p.data[:syntax.Offset], []byte("\n")) + 1, Msg: syntax.Error()}
+	}
+	if syntax != nil || err == io.EOF || err == io.ErrUnexpectedEOF {
+		return &Error{Line: bytes.Count(p.data, []byte("\n")) + 1, Msg: "unexpected end of file"}
+	}
+	return &Error{Line: p.line(), Msg: err.Error()}
+}
@@ -0,0 +1,250 @@
+// Package themefile reads themes from JSON or YAML files.
+//
+// A theme file mirrors theme_data.ThemeData with lower camel case keys:
+//
+//	name: brand
+//	title: Brand
+//	dark: false
+//	boxTheme:
+//	  containerStyle:
+//	    primary:
+//	      backgroundColor: "#FDFDFD"
+//	      borderColor: "#ECECEC"
+//	textTheme:
+//	  textStyle:
+//	    primary:
+//	      color: "#1F2937"
+//	      fontFamily: Ubuntu
+//	tokens:
+//	  accent: "#2B799B"
+//
+// Colours are "#RRGGBB" or "#RRGGBBAA" strings, quoted in YAML where # starts
+// a comment. Keys left out keep the values of the light theme, or of the dark
+// theme when dark is true. Unknown keys and values of the wrong type are
+// errors with the line they are on.
+//
+// The package has no gofred imports so the host tools can check theme files;
+// theme.Load in app/theme turns a File into theme data.
+package themefile
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Color is a colour as the hex literal color.From takes, 0xRRGGBBAA
+type Color uint32
+
+// String returns the colour as "#RRGGBB", or "#RRGGBBAA" if it is not
+// opaque
+func (c Color) String() string {
+	if c&0xff == 0xff {
+		return fmt.Sprintf("#%06X", uint32(c)>>8)
+	}
+	return fmt.Sprintf("#%08X", uint32(c))
+}
+
+// ParseColor reads "#RRGGBB" or "#RRGGBBAA"
+func ParseColor(text string) (Color, error) {
+	hex, ok := strings.CutPrefix(text, "#")
+	if !ok || (len(hex) != 6 && len(hex) != 8) {
+		return 0, fmt.Errorf("colour %q must be #RRGGBB or #RRGGBBAA", text)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("colour %q has characters other than hex digits", text)
+	}
+	return Color(value), nil
+}
+
+// File is a parsed theme file. Unset styles and fields are nil.
+type File struct {
+	Name  string `key:"name" required:"true"`
+	Title string `key:"title" required:"true"`
+
+	// Dark marks themes with light text on dark backgrounds
+	Dark bool `key:"dark"`
+
+	BoxTheme    *BoxTheme    `key:"boxTheme"`
+	ButtonTheme *ButtonTheme `key:"buttonTheme"`
+	TextTheme   *TextTheme   `key:"textTheme"`
+
+	Tokens *Tokens `key:"tokens"`
+	Syntax *Syntax `key:"syntax"`
+}
+
+// Syntax mirrors theme.Syntax
+type Syntax struct {
+	Keyword  *Color `key:"keyword"`
+	String   *Color `key:"string"`
+	Number   *Color `key:"number"`
+	Comment  *Color `key:"comment"`
+	Type     *Color `key:"type"`
+	Function *Color `key:"function"`
+	Builtin  *Color `key:"builtin"`
+	Variable *Color `key:"variable"`
+	Key      *Color `key:"key"`
+
+	Added   *Color `key:"added"`
+	Removed *Color `key:"removed"`
+}
+
+// Tokens mirrors theme.Tokens
+type Tokens struct {
+	Background   *Color `key:"background"`
+	OnBackground *Color `key:"onBackground"`
+	Mark         *Color `key:"mark"`
+	Scrim        *Color `key:"scrim"`
+
+	Primary     *Color `key:"primary"`
+	OnPrimary   *Color `key:"onPrimary"`
+	OnSecondary *Color `key:"onSecondary"`
+	OnTertiary  *Color `key:"onTertiary"`
+
+	Accent        *Color `key:"accent"`
+	AccentSurface *Color `key:"accentSurface"`
+	AccentBorder  *Color `key:"accentBorder"`
+	Highlight     *Color `key:"highlight"`
+
+	Success        *Color `key:"success"`
+	SuccessText    *Color `key:"successText"`
+	SuccessSurface *Color `key:"successSurface"`
+	Error          *Color `key:"error"`
+	Warning        *Color `key:"warning"`
+	Info           *Color `key:"info"`
+	Neutral        *Color `key:"neutral"`
+	OnStatus       *Color `key:"onStatus"`
+
+	Muted         *Color `key:"muted"`
+	Subtle        *Color `key:"subtle"`
+	Surface       *Color `key:"surface"`
+	SurfaceBorder *Color `key:"surfaceBorder"`
+}
+
+type BoxTheme struct {
+	CodeBlockStyle *ContainerStyleCollection `key:"codeBlockStyle"`
+	ContainerStyle *ContainerStyleCollection `key:"containerStyle"`
+}
+
+type ButtonTheme struct {
+	ButtonStyle     *ButtonStyleCollection `key:"buttonStyle"`
+	IconButtonStyle *ButtonStyleCollection `key:"iconButtonStyle"`
+}
+
+type TextTheme struct {
+	CodeBlockStyle *TextStyleCollection `key:"codeBlockStyle"`
+	TextStyle      *TextStyleCollection `key:"textStyle"`
+}
+
+type ContainerStyleCollection struct {
+	Primary   *ContainerStyle `key:"primary"`
+	Secondary *ContainerStyle `key:"secondary"`
+	Tertiary  *ContainerStyle `key:"tertiary"`
+}
+
+type ButtonStyleCollection struct {
+	Primary   *ButtonStyle `key:"primary"`
+	Secondary *ButtonStyle `key:"secondary"`
+}
+
+type TextStyleCollection struct {
+	Primary   *TextStyle `key:"primary"`
+	Secondary *TextStyle `key:"secondary"`
+	Tertiary  *TextStyle `key:"tertiary"`
+}
+
+type ContainerStyle struct {
+	BackgroundColor *Color `key:"backgroundColor"`
+	BorderColor     *Color `key:"borderColor"`
+}
+
+type ButtonStyle struct {
+	BackgroundColor *Color     `key:"backgroundColor"`
+	BorderColor     *Color     `key:"borderColor"`
+	BorderRadius    *int       `key:"borderRadius"`
+	BorderWidth     *int       `key:"borderWidth"`
+	BorderStyle     *string    `key:"borderStyle" enum:"solid"`
+	Fill            *Color     `key:"fill"`
+	TextStyle       *TextStyle `key:"textStyle"`
+}
+
+type TextStyle struct {
+	FontSize   *int    `key:"fontSize"`
+	FontWeight *string `key:"fontWeight" enum:"100,200,300,400,500,600,700,800,900"`
+	Color      *Color  `key:"color"`
+	FontFamily *string `key:"fontFamily"`
+}
+
+// Error is a problem with a theme file
+type Error struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// Errors are all the problems found in a theme file, in file order
+type Errors []*Error
+
+func (e Errors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Parse reads the theme file called name, JSON for names ending in .json and
+// YAML for .yaml or .yml. Problems with the content are returned as Errors.
+func Parse(name string, data []byte) (*File, error) {
+	var root *node
+	var err error
+	switch path.Ext(name) {
+	case ".json":
+		root, err = parseJSON(data)
+	case ".yaml", ".yml":
+		root, err = parseYAML(data)
+	default:
+		return nil, fmt.Errorf("%s: theme files are .json, .yaml or .yml", name)
+	}
+	if err != nil {
+		if syntax, ok := err.(*Error); ok {
+			syntax.File = name
+			return nil, Errors{syntax}
+		}
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	d := decoder{file: name}
+	file := &File{}
+	d.decode(root, file, "")
+	if file.Name != "" && !isSlug(file.Name) {
+		d.fail(root.field("name").line, "name %q must be lower case letters, digits and dashes", file.Name)
+	}
+	if len(d.errors) > 0 {
+		// Missing keys are found after the mapping they belong to
+		sort.SliceStable(d.errors, func(i, j int) bool {
+			return d.errors[i].Line < d.errors[j].Line
+		})
+		return nil, d.errors
+	}
+	return file, nil
+}
+
+func isSlug(name string) bool {
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
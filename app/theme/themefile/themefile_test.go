@@ -0,0 +1,229 @@
+package themefile
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func color(c Color) *Color { return &c }
+func number(n int) *int    { return &n }
+func str(s string) *string { return &s }
+
+const brandYAML = `# A theme for the brand
+name: brand
+title: Brand
+dark: true
+buttonTheme:
+  buttonStyle:
+    primary:
+      backgroundColor: '#2B799B'
+      borderRadius: 8
+      textStyle:
+        fontWeight: 500
+        fontFamily: Ubuntu
+tokens:
+  accent: "#2B799B80" # translucent
+`
+
+const brandJSON = `{
+  "name": "brand",
+  "title": "Brand",
+  "dark": true,
+  "buttonTheme": {
+    "buttonStyle": {
+      "primary": {
+        "backgroundColor": "#2B799B",
+        "borderRadius": 8,
+        "textStyle": {"fontWeight": "500", "fontFamily": "Ubuntu"}
+      }
+    }
+  },
+  "tokens": {"accent": "#2B799B80"}
+}
+`
+
+func TestParse(t *testing.T) {
+	want := &File{
+		Name:  "brand",
+		Title: "Brand",
+		Dark:  true,
+		ButtonTheme: &ButtonTheme{
+			ButtonStyle: &ButtonStyleCollection{
+				Primary: &ButtonStyle{
+					BackgroundColor: color(0x2B799BFF),
+					BorderRadius:    number(8),
+					TextStyle:       &TextStyle{FontWeight: str("500"), FontFamily: str("Ubuntu")},
+				},
+			},
+		},
+		Tokens: &Tokens{Accent: color(0x2B799B80)},
+	}
+
+	for name, data := range map[string]string{"brand.yaml": brandYAML, "brand.json": brandJSON} {
+		got, err := Parse(name, []byte(data))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s:\n got %+v\nwant %+v", name, got, want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+		want []string
+	}{
+		// Colours
+		{
+			"unquoted colour", "t.yaml",
+			"name: t\ntitle: T\ntokens:\n  accent: #2B799B\n",
+			[]string{`t.yaml:4: accent has no value, quote colours like "#1F2937" since # starts a comment`},
+		},
+		{
+			"short colour", "t.yaml",
+			"name: t\ntitle: T\ntokens:\n  accent: \"#FFF\"\n",
+			[]string{`t.yaml:4: tokens.accent: colour "#FFF" must be #RRGGBB or #RRGGBBAA`},
+		},
+		{
+			"colour without #", "t.json",
+			`{"name": "t", "title": "T", "tokens": {"accent": "2B799B"}}`,
+			[]string{`t.json:1: tokens.accent: colour "2B799B" must be #RRGGBB or #RRGGBBAA`},
+		},
+		{
+			"colour with non hex digits", "t.yaml",
+			"name: t\ntitle: T\n\ntokens:\n  accent: '#2B799G'\n",
+			[]string{`t.yaml:5: tokens.accent: colour "#2B799G" has characters other than hex digits`},
+		},
+		{
+			"colour that is a number", "t.json",
+			"{\n  \"name\": \"t\",\n  \"title\": \"T\",\n  \"tokens\": {\n    \"accent\": 123456\n  }\n}\n",
+			[]string{`t.json:5: tokens.accent must be a colour string like "#1F2937"`},
+		},
+
+		// Lists
+		{
+			"YAML list", "t.yaml",
+			"name: t\ntitle: T\ntokens:\n  - accent\n",
+			[]string{"t.yaml:4: lists are not part of a theme"},
+		},
+		{
+			"YAML flow list", "t.yaml",
+			"name: t\ntitle: T\ntokens: [accent]\n",
+			[]string{"t.yaml:3: only plain and quoted values are supported, got [accent]"},
+		},
+		{
+			"JSON list", "t.json",
+			"{\n  \"name\": \"t\",\n  \"title\": \"T\",\n  \"tokens\": [\"#FFFFFF\"]\n}\n",
+			[]string{"t.json:4: lists are not part of a theme"},
+		},
+
+		// Trailing content and syntax
+		{
+			"trailing JSON", "t.json",
+			"{\"name\": \"t\", \"title\": \"T\"}\n\n{\"name\": \"u\"}\n",
+			[]string{"t.json:3: unexpected content after the theme"},
+		},
+		{
+			"unterminated JSON", "t.json",
+			"{\n  \"name\": \"t\",\n",
+			[]string{"t.json:3: unexpected end of file"},
+		},
+		{
+			"missing comma", "t.json",
+			"{\n  \"name\": \"t\"\n  \"title\": \"T\"\n}\n",
+			[]string{"t.json:3: invalid character '\"' after object key:value pair"},
+		},
+		{
+			"tab indent", "t.yaml",
+			"name: t\ntitle: T\ntokens:\n\taccent: '#FFFFFF'\n",
+			[]string{"t.yaml:4: indent with spaces, YAML does not allow tabs"},
+		},
+		{
+			"null", "t.yaml",
+			"name: t\ntitle: T\ndark: ~\n",
+			[]string{"t.yaml:3: null is not a value, leave the key out instead"},
+		},
+
+		// Empty files
+		{"empty YAML", "t.yaml", "", []string{"t.yaml:1: the theme file is empty"}},
+		{"YAML with only comments", "t.yml", "# nothing yet\n---\n", []string{"t.yml:1: the theme file is empty"}},
+		{"empty JSON", "t.json", "", []string{"t.json:1: unexpected end of file"}},
+
+		// Keys and values
+		{
+			"every problem with its line", "t.yaml",
+			"name: Brand Theme\n# the title is missing\ndark: yes\ntokenz:\n  accent: '#FFFFFF'\nbuttonTheme:\n  buttonStyle:\n    primary:\n      borderRadius: 1.5\n      textStyle:\n        fontWeight: bold\n",
+			[]string{
+				"t.yaml:1: the theme is missing title",
+				"t.yaml:1: name \"Brand Theme\" must be lower case letters, digits and dashes",
+				"t.yaml:3: dark must be true or false",
+				"t.yaml:4: unknown key \"tokenz\" in the theme, want one of name, title, dark, boxTheme, buttonTheme, textTheme, tokens, syntax",
+				"t.yaml:9: buttonTheme.buttonStyle.primary.borderRadius must be a whole number of pixels",
+				"t.yaml:11: buttonTheme.buttonStyle.primary.textStyle.fontWeight is \"bold\", want one of 100, 200, 300, 400, 500, 600, 700, 800, 900",
+			},
+		},
+		{
+			"key set twice", "t.json",
+			"{\n  \"name\": \"t\",\n  \"title\": \"T\",\n  \"title\": \"U\"\n}\n",
+			[]string{"t.json:4: title is set twice"},
+		},
+		{
+			"mapping where a value goes", "t.yaml",
+			"name: t\ntitle:\n  text: T\n",
+			[]string{"t.yaml:2: title must be a string, not a mapping"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := Parse(tt.file, []byte(tt.data))
+			if err == nil {
+				t.Fatalf("parsed into %+v", file)
+			}
+			var list Errors
+			if !errors.As(err, &list) {
+				t.Fatalf("error %v is not Errors", err)
+			}
+			var got []string
+			for _, e := range list {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("errors:\n got %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseExtension(t *testing.T) {
+	_, err := Parse("theme.toml", []byte("name = 't'"))
+	var list Errors
+	if err == nil || errors.As(err, &list) {
+		t.Errorf("Parse of a .toml file = %v, want a plain error", err)
+	}
+}
+
+func TestColor(t *testing.T) {
+	for text, want := range map[string]Color{
+		"#2B799B":   0x2B799BFF,
+		"#2b799b":   0x2B799BFF,
+		"#00000080": 0x00000080,
+	} {
+		got, err := ParseColor(text)
+		if err != nil || got != want {
+			t.Errorf("ParseColor(%q) = %#x, %v, want %#x", text, got, err, want)
+		}
+	}
+
+	for c, want := range map[Color]string{0x2B799BFF: "#2B799B", 0x00000080: "#00000080"} {
+		if got := c.String(); got != want {
+			t.Errorf("Color(%#x).String() = %q, want %q", uint32(c), got, want)
+		}
+	}
+}
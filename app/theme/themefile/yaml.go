@@ -0,0 +1,174 @@
+package themefile
+
+import (
+	"strconv"
+	"strings"
+)
+
+// The YAML a theme needs: nested block mappings of scalars, with comments.
+// Lists, flow collections, anchors and multi-line strings are rejected.
+
+type yamlLine struct {
+	number int
+	indent int
+	key    string
+	value  string
+
+	// comment is set when a # cut the value short, the usual mistake with
+	// unquoted colours
+	comment bool
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML reads a YAML document into nodes
+func parseYAML(data []byte) (*node, error) {
+	var p yamlParser
+	for i, text := range strings.Split(string(data), "\n") {
+		number := i + 1
+		text = strings.TrimRight(text, "\r")
+		content, comment := stripComment(text)
+		if strings.TrimSpace(content) == "" || len(p.lines) == 0 && strings.TrimSpace(content) == "---" {
+			continue
+		}
+
+		trimmed := strings.TrimLeft(content, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, &Error{Line: number, Msg: "indent with spaces, YAML does not allow tabs"}
+		}
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			return nil, &Error{Line: number, Msg: "lists are not part of a theme"}
+		}
+
+		key, value, ok := splitKey(strings.TrimSpace(trimmed))
+		if !ok {
+			return nil, &Error{Line: number, Msg: "want key: value"}
+		}
+		p.lines = append(p.lines, yamlLine{
+			number:  number,
+			indent:  len(content) - len(trimmed),
+			key:     key,
+			value:   value,
+			comment: comment,
+		})
+	}
+
+	if len(p.lines) == 0 {
+		return nil, &Error{Line: 1, Msg: "the theme file is empty"}
+	}
+	if p.lines[0].indent != 0 {
+		return nil, &Error{Line: p.lines[0].number, Msg: "the top level keys must not be indented"}
+	}
+	return p.mapping(0, 1)
+}
+
+// mapping reads the keys at indent
+func (p *yamlParser) mapping(indent, line int) (*node, error) {
+	n := &node{line: line, isMap: true}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, &Error{Line: l.number, Msg: "unexpected indentation"}
+		}
+		p.pos++
+
+		if l.value != "" {
+			value, err := yamlScalar(l)
+			if err != nil {
+				return nil, err
+			}
+			n.fields = append(n.fields, field{key: l.key, value: value})
+			continue
+		}
+
+		if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+			child, err := p.mapping(p.lines[p.pos].indent, l.number)
+			if err != nil {
+				return nil, err
+			}
+			n.fields = append(n.fields, field{key: l.key, value: child})
+			continue
+		}
+
+		msg := l.key + " has no value"
+		if l.comment {
+			msg += ", quote colours like \"#1F2937\" since # starts a comment"
+		}
+		return nil, &Error{Line: l.number, Msg: msg}
+	}
+	return n, nil
+}
+
+// yamlScalar reads the value of a line as a string, number or boolean
+func yamlScalar(l yamlLine) (*node, error) {
+	value := l.value
+	n := &node{line: l.number}
+
+	switch {
+	case strings.HasPrefix(value, `"`):
+		text, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, &Error{Line: l.number, Msg: "malformed double quoted string " + value}
+		}
+		n.scalar = text
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return nil, &Error{Line: l.number, Msg: "malformed single quoted string " + value}
+		}
+		n.scalar = strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+	case strings.ContainsAny(value[:1], "{[&*!|>"):
+		return nil, &Error{Line: l.number, Msg: "only plain and quoted values are supported, got " + value}
+	case value == "true" || value == "false":
+		n.scalar = value == "true"
+	case value == "null" || value == "~":
+		return nil, &Error{Line: l.number, Msg: "null is not a value, leave the key out instead"}
+	default:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			n.scalar = number
+		} else {
+			n.scalar = value
+		}
+	}
+	return n, nil
+}
+
+// splitKey splits "key: value" at the first colon followed by a space or
+// the end of the line
+func splitKey(text string) (key, value string, ok bool) {
+	for i := 0; i < len(text); i++ {
+		if text[i] != ':' || (i+1 < len(text) && text[i+1] != ' ') {
+			continue
+		}
+		key = strings.TrimSpace(text[:i])
+		if unquoted, err := strconv.Unquote(key); err == nil {
+			key = unquoted
+		}
+		return key, strings.TrimSpace(text[i+1:]), key != ""
+	}
+	return "", "", false
+}
+
+// stripComment cuts a # comment that is outside quotes and starts the line
+// or follows a space
+func stripComment(text string) (string, bool) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || text[i-1] == ' ' || text[i-1] == '\t'):
+			return text[:i], true
+		}
+	}
+	return text, false
+}
@@ -0,0 +1,93 @@
+package theme
+
+import "github.com/gofred-io/gofred-website/app/browser"
+
+// Tokens are the colours widgets pick by meaning rather than through a
+// theme_data style, like the fill of an icon or the background of a status.
+// They are "#RRGGBB" strings for the options taking CSS colours, such as
+// icon.Fill and container.BackgroundColor.
+type Tokens struct {
+	// Background and OnBackground are the page and the text on it, for the
+	// components built with plain DOM, which can't take a theme_data style.
+	// Mark is the background of the matched text in search results and
+	// Scrim, which may be translucent, dims the page behind the drawers.
+	Background   string
+	OnBackground string
+	Mark         string
+	Scrim        string
+
+	// Primary is the background of primary actions, OnPrimary the text and
+	// icons on it. OnSecondary is for icons on secondary buttons and
+	// OnTertiary for icons on tertiary containers, like the footer.
+	Primary     string
+	OnPrimary   string
+	OnSecondary string
+	OnTertiary  string
+
+	// Accent is the brand colour of links, highlighted titles and icons.
+	// AccentSurface and AccentBorder tint panels that go with it.
+	Accent        string
+	AccentSurface string
+	AccentBorder  string
+
+	// Highlight is a second accent for illustrations
+	Highlight string
+
+	// Status colours, for icons, snackbars and badges. OnStatus is the text
+	// and icons on any of them and SuccessText the text on SuccessSurface.
+	// Neutral is the status of plain messages.
+	Success        string
+	SuccessText    string
+	SuccessSurface string
+	Error          string
+	Warning        string
+	Info           string
+	Neutral        string
+	OnStatus       string
+
+	// Muted is for secondary icons and Subtle for decorative ones. Surface
+	// and SurfaceBorder set apart panels and badges with little emphasis.
+	Muted         string
+	Subtle        string
+	Surface       string
+	SurfaceBorder string
+}
+
+// Colors returns the tokens of the theme shown
+func Colors() Tokens {
+	return current.Tokens
+}
+
+// applyTokens sets the tokens as --color-<token> custom properties, like
+// --color-on-primary, for the rules of web/index.css styling the components
+// built with plain DOM
+func applyTokens(t Tokens) {
+	for name, value := range map[string]string{
+		"background":      t.Background,
+		"on-background":   t.OnBackground,
+		"mark":            t.Mark,
+		"scrim":           t.Scrim,
+		"primary":         t.Primary,
+		"on-primary":      t.OnPrimary,
+		"on-secondary":    t.OnSecondary,
+		"on-tertiary":     t.OnTertiary,
+		"accent":          t.Accent,
+		"accent-surface":  t.AccentSurface,
+		"accent-border":   t.AccentBorder,
+		"highlight":       t.Highlight,
+		"success":         t.Success,
+		"success-text":    t.SuccessText,
+		"success-surface": t.SuccessSurface,
+		"error":           t.Error,
+		"warning":         t.Warning,
+		"info":            t.Info,
+		"neutral":         t.Neutral,
+		"on-status":       t.OnStatus,
+		"muted":           t.Muted,
+		"subtle":          t.Subtle,
+		"surface":         t.Surface,
+		"surface-border":  t.SurfaceBorder,
+	} {
+		browser.SetDocumentStyle("--color-"+name, value)
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bufio"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/internal/themes"
+)
+
+const (
+	appDir = "app"
+
+	// styleSheet is the CSS of the components built with plain DOM
+	styleSheet = "web/index.css"
+)
+
+var (
+	hexColor = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{4}|[0-9A-Fa-f]{6}|[0-9A-Fa-f]{8})$`)
+
+	// cssColor finds the colours in the value of a declaration
+	cssColor = regexp.MustCompile(`#[0-9A-Za-z]+|rgba?\([^)]*\)`)
+
+	// declaration is a "property: value;" line of a rule
+	declaration = regexp.MustCompile(`^\s*([-a-zA-Z]+)\s*:\s*(.*?);?\s*$`)
+)
+
+// literal is a colour written into the sources instead of taken from the
+// theme
+type literal struct {
+	File  string
+	Line  int
+	Value string
+
+	// Call is the function the colour is passed to, like text.FontColor,
+	// or the CSS property it is the value of
+	Call string
+}
+
+// literals returns the hex colour strings and color.From literals of the
+// Go files under app/, except the theme package that is meant to have them,
+// followed by the colours of web/index.css
+func literals(root string) ([]literal, error) {
+	fset := token.NewFileSet()
+	var found []literal
+
+	err := filepath.WalkDir(filepath.Join(root, appDir), func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if entry.IsDir() {
+			if rel == themes.Dir || strings.HasPrefix(rel, themes.Dir+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+			return nil
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		calls := make(map[*ast.BasicLit]string)
+		ast.Inspect(parsed, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name := callName(call.Fun)
+			for _, arg := range call.Args {
+				if lit, ok := arg.(*ast.BasicLit); ok {
+					calls[lit] = name
+				}
+			}
+			return true
+		})
+
+		ast.Inspect(parsed, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+			value := lit.Value
+			switch lit.Kind {
+			case token.STRING:
+				value = strings.Trim(value, "\"`")
+				if !hexColor.MatchString(value) || transparent(value) {
+					return true
+				}
+			case token.INT:
+				if calls[lit] != "color.From" {
+					return true
+				}
+			default:
+				return true
+			}
+			found = append(found, literal{
+				File:  rel,
+				Line:  fset.Position(lit.Pos()).Line,
+				Value: value,
+				Call:  calls[lit],
+			})
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	css, err := styleLiterals(root, styleSheet)
+	return append(found, css...), err
+}
+
+// styleLiterals returns the hex and rgb() colours in the declarations of a
+// style sheet. Custom properties are left out, var(--color-accent) is how
+// the style sheet follows the theme.
+func styleLiterals(root, name string) ([]literal, error) {
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var found []literal
+	comment := false
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := uncomment(scanner.Text(), &comment)
+		m := declaration.FindStringSubmatch(text)
+		if m == nil || strings.HasPrefix(m[1], "--") {
+			continue
+		}
+		for _, value := range cssColor.FindAllString(m[2], -1) {
+			if strings.HasPrefix(value, "#") && !hexColor.MatchString(value) {
+				continue
+			}
+			if transparent(value) {
+				continue
+			}
+			found = append(found, literal{File: name, Line: line, Value: value, Call: m[1]})
+		}
+	}
+	return found, scanner.Err()
+}
+
+// uncomment removes the comments from a line of CSS, comment tells whether
+// one is still open from the lines before
+func uncomment(line string, comment *bool) string {
+	var out strings.Builder
+	for line != "" {
+		if *comment {
+			_, rest, ok := strings.Cut(line, "*/")
+			if !ok {
+				return out.String()
+			}
+			*comment, line = false, rest
+			continue
+		}
+		before, rest, ok := strings.Cut(line, "/*")
+		out.WriteString(before)
+		if !ok {
+			break
+		}
+		*comment, line = true, rest
+	}
+	return out.String()
+}
+
+// transparent reports whether a colour has zero alpha, like "#00000000" or
+// "rgba(0, 0, 0, 0)", which looks the same in every theme
+func transparent(color string) bool {
+	if strings.HasPrefix(color, "rgb") {
+		args := strings.FieldsFunc(strings.Trim(color, "rgba()"), func(r rune) bool {
+			return r == ',' || r == ' ' || r == '/'
+		})
+		return len(args) == 4 && strings.Trim(args[3], "0.%") == ""
+	}
+	return (len(color) == 9 && strings.HasSuffix(color, "00")) || (len(color) == 5 && strings.HasSuffix(color, "0"))
+}
+
+// callName returns pkg.Func or Func for the function of a call
+func callName(fun ast.Expr) string {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		if x, ok := fun.X.(*ast.Ident); ok {
+			return x.Name + "." + fun.Sel.Name
+		}
+		return fun.Sel.Name
+	}
+	return ""
+}
@@ -0,0 +1,224 @@
+// Contrast audits the colours of the registered themes for WCAG contrast.
+//
+// For every theme read by internal/themes, each foreground the widgets put
+// on a background, like the secondary text style on the secondary container
+// or the fill of an icon button on the page, is given its contrast ratio and
+// the WCAG level it reaches. Text needs 4.5:1 for AA and 7:1 for AAA, icons
+// 3:1. Translucent colours are composited on the background first.
+//
+// The Go sources under app/ are also scanned for colours written outside the
+// theme, hex strings like text.FontColor("#6B7280") and color.From literals.
+// So are the hex and rgb() colours of web/index.css that don't go through a
+// var(--color-...) property. They are listed as file:line, they don't follow
+// the theme picker.
+//
+// The exit status is 1 if a pairing is below -level, AA by default. Listed
+// literals don't fail the run.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+	"github.com/gofred-io/gofred-website/internal/themes"
+)
+
+// pair is a foreground the app shows on a background, as ThemeData fields
+type pair struct {
+	Foreground string
+	Background string
+
+	// Graphical marks icons, which have lower requirements than text
+	Graphical bool
+}
+
+const (
+	// page is the background everything else sits on
+	page = "BoxTheme.ContainerStyle.Primary.BackgroundColor"
+
+	// code is the background of code blocks and their highlighted tokens
+	code = "BoxTheme.CodeBlockStyle.Primary.BackgroundColor"
+)
+
+var pairs = []pair{
+	{"TextTheme.TextStyle.Primary.Color", page, false},
+	{"TextTheme.TextStyle.Secondary.Color", page, false},
+	{"TextTheme.TextStyle.Primary.Color", "BoxTheme.ContainerStyle.Secondary.BackgroundColor", false},
+	{"TextTheme.TextStyle.Secondary.Color", "BoxTheme.ContainerStyle.Secondary.BackgroundColor", false},
+	{"TextTheme.TextStyle.Tertiary.Color", "BoxTheme.ContainerStyle.Tertiary.BackgroundColor", false},
+	{"TextTheme.CodeBlockStyle.Primary.Color", code, false},
+	{"ButtonTheme.ButtonStyle.Primary.TextStyle.Color", "ButtonTheme.ButtonStyle.Primary.BackgroundColor", false},
+	{"ButtonTheme.ButtonStyle.Secondary.TextStyle.Color", "ButtonTheme.ButtonStyle.Secondary.BackgroundColor", false},
+	{"ButtonTheme.IconButtonStyle.Primary.Fill", "ButtonTheme.IconButtonStyle.Primary.BackgroundColor", true},
+	{"ButtonTheme.IconButtonStyle.Secondary.Fill", "ButtonTheme.IconButtonStyle.Secondary.BackgroundColor", true},
+
+	{"Tokens.Accent", page, false},
+	{"Tokens.Muted", page, false},
+	{"Tokens.Muted", "Tokens.Surface", false},
+	{"Tokens.OnPrimary", "Tokens.Primary", false},
+	{"Tokens.SuccessText", "Tokens.SuccessSurface", false},
+	{"Tokens.OnSecondary", "ButtonTheme.ButtonStyle.Secondary.BackgroundColor", true},
+	{"Tokens.OnTertiary", "BoxTheme.ContainerStyle.Tertiary.BackgroundColor", true},
+	{"Tokens.Error", page, true},
+	{"Tokens.Success", page, true},
+
+	// Snackbars put OnStatus text on the colour of their status
+	{"Tokens.OnStatus", "Tokens.Success", false},
+	{"Tokens.OnStatus", "Tokens.Error", false},
+	{"Tokens.OnStatus", "Tokens.Warning", false},
+	{"Tokens.OnStatus", "Tokens.Info", false},
+	{"Tokens.OnStatus", "Tokens.Neutral", false},
+
+	{"Tokens.OnBackground", "Tokens.Background", false},
+	{"Tokens.OnBackground", "Tokens.Mark", false},
+
+	{"Syntax.Keyword", code, false},
+	{"Syntax.String", code, false},
+	{"Syntax.Number", code, false},
+	{"Syntax.Comment", code, false},
+	{"Syntax.Type", code, false},
+	{"Syntax.Function", code, false},
+	{"Syntax.Builtin", code, false},
+	{"Syntax.Variable", code, false},
+	{"Syntax.Key", code, false},
+}
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	level := flag.String("level", "AA", "lowest passing level, AA or AAA")
+	flag.Parse()
+
+	if *level != "AA" && *level != "AAA" {
+		fatal(fmt.Errorf("-level is AA or AAA, not %q", *level))
+	}
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	list, err := themes.Load(*root)
+	if err != nil {
+		fatal(err)
+	}
+
+	out := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(out, "THEME\tFOREGROUND\tBACKGROUND\tCOLOURS\tRATIO\tLEVEL")
+	failed := 0
+	for _, res := range audit(list) {
+		reached := res.Level
+		if !passes(res.Level, *level, res.Pair.Graphical) {
+			failed++
+			reached += " ✗"
+		}
+		fmt.Fprintf(out, "%s\t%s\t%s\t%s on %s\t%.2f:1\t%s\n",
+			res.Theme, short(res.Pair.Foreground), short(res.Pair.Background), res.Above, res.Below, res.Ratio, reached)
+	}
+	out.Flush()
+
+	found, err := literals(*root)
+	if err != nil {
+		fatal(err)
+	}
+	if len(found) > 0 {
+		fmt.Printf("\n%d colours outside the theme:\n", len(found))
+		for _, l := range found {
+			if l.Call != "" {
+				fmt.Printf("%s:%d: %s in %s\n", l.File, l.Line, l.Value, l.Call)
+			} else {
+				fmt.Printf("%s:%d: %s\n", l.File, l.Line, l.Value)
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "%d pairings below %s\n", failed, *level)
+		os.Exit(1)
+	}
+}
+
+// result is the contrast of a pair in a theme
+type result struct {
+	Theme string
+	Pair  pair
+
+	// Above and Below are the colours composited on the page
+	Above, Below rgb
+	Ratio        float64
+
+	// Level is the highest level reached, or "fail"
+	Level string
+}
+
+// audit measures every pair of every theme, skipping the pairs with a colour
+// the theme doesn't set
+func audit(list []themes.Theme) []result {
+	var results []result
+	for _, theme := range list {
+		for _, p := range pairs {
+			fg, okFg := theme.Colors[p.Foreground]
+			bg, okBg := theme.Colors[p.Background]
+			if !okFg || !okBg {
+				continue
+			}
+
+			// Backgrounds are laid on the page, which is laid on white
+			pageColor := over(theme.Colors[page], rgb{1, 1, 1})
+			below := over(bg, pageColor)
+			above := over(fg, below)
+			r := ratio(above, below)
+			results = append(results, result{
+				Theme: theme.Name,
+				Pair:  p,
+				Above: above,
+				Below: below,
+				Ratio: r,
+				Level: grade(r, p.Graphical),
+			})
+		}
+	}
+	return results
+}
+
+// grade returns the highest level the ratio reaches, or "fail"
+func grade(r float64, graphical bool) string {
+	switch {
+	case graphical && r >= graphicalAA:
+		return "AA"
+	case !graphical && r >= textAAA:
+		return "AAA"
+	case !graphical && r >= textAA:
+		return "AA"
+	}
+	return "fail"
+}
+
+// passes reports whether reached meets level. Icons have no AAA level, AA
+// is enough for them.
+func passes(reached, level string, graphical bool) bool {
+	switch reached {
+	case "AAA":
+		return true
+	case "AA":
+		return level == "AA" || graphical
+	}
+	return false
+}
+
+// short drops the theme part of a field, "TextStyle.Primary.Color" is clear
+// enough in the table
+func short(field string) string {
+	_, rest, _ := strings.Cut(field, ".")
+	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(rest, ".Color"), ".BackgroundColor"), ".Fill")
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "contrast:", err)
+	os.Exit(1)
+}
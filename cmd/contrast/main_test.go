@@ -0,0 +1,110 @@
+package main
+
+import (
+	"math"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/internal/themes"
+)
+
+func TestRatio(t *testing.T) {
+	tests := []struct {
+		fg, bg themes.Color
+		want   float64
+	}{
+		{0x000000FF, 0xFFFFFFFF, 21},
+		{0xFFFFFFFF, 0x000000FF, 21},
+		{0x767676FF, 0xFFFFFFFF, 4.54},
+		{0xFFFFFFFF, 0xFFFFFFFF, 1},
+
+		// Half transparent black on white is mid grey
+		{0x00000080, 0xFFFFFFFF, 4.00},
+	}
+	for _, tt := range tests {
+		below := over(tt.bg, rgb{1, 1, 1})
+		got := ratio(over(tt.fg, below), below)
+		if math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("ratio(%s on %s) = %.2f, want %.2f", tt.fg, tt.bg, got, tt.want)
+		}
+	}
+}
+
+func TestGrade(t *testing.T) {
+	tests := []struct {
+		ratio     float64
+		graphical bool
+		want      string
+		passesAA  bool
+		passesAAA bool
+	}{
+		{7, false, "AAA", true, true},
+		{4.5, false, "AA", true, false},
+		{4.49, false, "fail", false, false},
+		{3, true, "AA", true, true},
+		{2.99, true, "fail", false, false},
+	}
+	for _, tt := range tests {
+		got := grade(tt.ratio, tt.graphical)
+		if got != tt.want {
+			t.Errorf("grade(%v, %v) = %s, want %s", tt.ratio, tt.graphical, got, tt.want)
+		}
+		if passes(got, "AA", tt.graphical) != tt.passesAA || passes(got, "AAA", tt.graphical) != tt.passesAAA {
+			t.Errorf("passes(%s, graphical %v) wrong for AA or AAA", got, tt.graphical)
+		}
+	}
+}
+
+func TestLiterals(t *testing.T) {
+	got, err := literals(filepath.Join("testdata", "site"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The theme package, transparent colours, comments and custom
+	// properties are left out
+	want := []literal{
+		{File: "app/pages/home/home.go", Line: 4, Value: "#6B7280", Call: "text.FontColor"},
+		{File: "app/pages/home/home.go", Line: 5, Value: "0x1F2937FF", Call: "color.From"},
+		{File: "app/pages/home/home.go", Line: 8, Value: "#FFF"},
+		{File: "web/index.css", Line: 7, Value: "#FFFFFF", Call: "background-color"},
+		{File: "web/index.css", Line: 14, Value: "rgba(0, 0, 0, 0.25)", Call: "box-shadow"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("literals:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+// TestThemes fails when a colour of a registered theme drops below AA
+func TestThemes(t *testing.T) {
+	list, err := themes.Load(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := audit(list)
+	measured := map[string]bool{}
+	for _, res := range results {
+		measured[res.Theme] = true
+		if !passes(res.Level, "AA", res.Pair.Graphical) {
+			t.Errorf("%s: %s on %s is %.2f:1, below AA", res.Theme, res.Pair.Foreground, res.Pair.Background, res.Ratio)
+		}
+	}
+	for _, theme := range list {
+		if !measured[theme.Name] {
+			t.Errorf("no colours of theme %s measured", theme.Name)
+		}
+	}
+
+	// The snackbar text is checked on every status colour
+	for _, status := range []string{"Success", "Error", "Warning", "Info"} {
+		found := false
+		for _, res := range results {
+			found = found || res.Pair == pair{"Tokens.OnStatus", "Tokens." + status, false}
+		}
+		if !found {
+			t.Errorf("OnStatus is not measured on %s", status)
+		}
+	}
+}
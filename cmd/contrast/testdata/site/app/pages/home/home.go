@@ -0,0 +1,10 @@
+package home
+
+func Home() {
+	text.New("Welcome", text.FontColor("#6B7280"))
+	container.New(nil, container.BackgroundColor(color.From(0x1F2937FF)))
+	container.New(nil, container.BackgroundColor("#00000000"))
+	text.New("#hashtag")
+	border := "#FFF"
+	_ = border
+}
@@ -0,0 +1,3 @@
+package theme
+
+const Background = "#FFFFFF"
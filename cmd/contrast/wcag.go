@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gofred-io/gofred-website/internal/themes"
+)
+
+// Minimum contrast ratios of WCAG 2.2. Text is 1.4.3 and 1.4.6, icons and
+// borders that carry meaning are 1.4.11, which has no AAA level.
+const (
+	textAA      = 4.5
+	textAAA     = 7
+	graphicalAA = 3
+)
+
+// rgb is a colour with its channels from 0 to 1
+type rgb struct {
+	r, g, b float64
+}
+
+func channels(c themes.Color) (rgb, float64) {
+	return rgb{
+		r: float64(c>>24&0xff) / 255,
+		g: float64(c>>16&0xff) / 255,
+		b: float64(c>>8&0xff) / 255,
+	}, float64(c&0xff) / 255
+}
+
+// over composites c on the opaque colour below
+func over(c themes.Color, below rgb) rgb {
+	top, alpha := channels(c)
+	return rgb{
+		r: top.r*alpha + below.r*(1-alpha),
+		g: top.g*alpha + below.g*(1-alpha),
+		b: top.b*alpha + below.b*(1-alpha),
+	}
+}
+
+// luminance is the relative luminance of WCAG
+func (c rgb) luminance() float64 {
+	linear := func(v float64) float64 {
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.r) + 0.7152*linear(c.g) + 0.0722*linear(c.b)
+}
+
+func (c rgb) String() string {
+	return fmt.Sprintf("#%02X%02X%02X", int(math.Round(c.r*255)), int(math.Round(c.g*255)), int(math.Round(c.b*255)))
+}
+
+// ratio is the contrast ratio of two opaque colours, from 1 to 21
+func ratio(a, b rgb) float64 {
+	la, lb := a.luminance(), b.luminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// builder compiles the app to WebAssembly
+type builder struct {
+	root   string
+	output string
+
+	mu sync.Mutex
+}
+
+// Build compiles main.wasm and returns the compiler output
+func (b *builder) Build() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cmd := exec.Command("go", "build", "-o", b.output, ".")
+	cmd.Dir = b.root
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+// generated returns whether a file, relative to root, is written by the
+// builder or the docs tools rather than by hand: main.wasm and the sitemap
+// of web, the search index, and the Go compiled from each Markdown docs page
+// with its index
+func generated(root, web string) func(file string) bool {
+	outputs := map[string]bool{
+		path.Join(web, "main.wasm"):           true,
+		path.Join(web, "sitemap.xml"):         true,
+		"app/search/index_gen.go":             true,
+		"app/pages/docs/content/pages_gen.go": true,
+	}
+	return func(file string) bool {
+		if outputs[file] {
+			return true
+		}
+		page, ok := strings.CutPrefix(file, "app/pages/docs/")
+		if !ok || path.Ext(page) != ".go" || strings.Count(page, "/") != 1 {
+			return false
+		}
+		_, err := os.Stat(filepath.Join(root, "app/pages/docs/content", strings.TrimSuffix(page, ".go")+".md"))
+		return err == nil
+	}
+}
+
+// Generate compiles the Markdown docs pages and rebuilds the search index,
+// like make docs
+func (b *builder) Generate() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cmd := exec.Command("go", "generate", "./app/pages/docs/content", "./app/search")
+	cmd.Dir = b.root
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
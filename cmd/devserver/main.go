@@ -0,0 +1,106 @@
+// Devserver builds main.wasm and serves the site for local development.
+//
+// Files are served from web/ with index.html as the fallback for the app's
+// routes, like the nginx config does in production. env.js is generated so
+// web/index.js loads the fresh build and connects to the /ws endpoint.
+//
+// app/, main.go and web/ are watched. After a change main.wasm is rebuilt and
+// every open page gets {"cmd":"reload"}, or {"cmd":"error"} with the compiler
+// output, which web/index.js shows over the page until the next good build.
+// POST /reload rebuilds without a change, for editors and scripts.
+//
+// POST /compile builds the programs of the /playground page, see package
+// internal/playground. Its URL is env.js's COMPILE_URL.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/gofred-io/gofred-website/internal/playground"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	web := flag.String("web", "web", "directory with index.html and the static assets, relative to the root")
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	watch := flag.Bool("watch", true, "rebuild and reload the pages when files change")
+	interval := flag.Duration("interval", 300*time.Millisecond, "how often to look for changed files")
+	compile := flag.Bool("compile", true, "compile the programs of the /playground page")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	buildDir, err := os.MkdirTemp("", "gofred-website-")
+	if err != nil {
+		fatal(err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	builder := &builder{root: *root, output: filepath.Join(buildDir, "main.wasm")}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fatal(err)
+	}
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		fatal(err)
+	}
+	livePort, err := strconv.Atoi(port)
+	if err != nil {
+		fatal(err)
+	}
+
+	s := &server{
+		web:      filepath.Join(*root, *web),
+		wasm:     builder.output,
+		livePort: livePort,
+		hub:      newHub(),
+		builder:  builder,
+	}
+	if *compile {
+		compiler, err := playground.New(*root)
+		if err != nil {
+			fatal(err)
+		}
+		defer compiler.Close()
+		s.compile = &playground.Handler{Compiler: compiler}
+	}
+	s.rebuild()
+
+	if *watch {
+		w := &watcher{
+			root:     *root,
+			paths:    []string{"app", "main.go", "go.mod", *web},
+			interval: *interval,
+			debounce: 200 * time.Millisecond,
+			skip:     generated(*root, filepath.ToSlash(*web)),
+		}
+		go w.Run(s.changed)
+	}
+
+	log.Printf("serving on http://localhost:%d", livePort)
+	if err := http.Serve(listener, s.routes()); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "devserver:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// message is the JSON sent to the pages over /ws. Output is the compiler
+// output of a failed build.
+type message struct {
+	Cmd    string `json:"cmd"`
+	Output string `json:"output,omitempty"`
+}
+
+// server serves the static files, the build and the live reload socket
+type server struct {
+	web      string
+	wasm     string
+	livePort int
+	hub      *hub
+	builder  *builder
+
+	// compile serves the /playground page's compile endpoint, nil when it
+	// is turned off
+	compile http.Handler
+
+	mu sync.Mutex
+	// failed is the message of the last build if it failed, for pages
+	// opened after it
+	failed *message
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/env.js", s.env)
+	mux.HandleFunc("/main.wasm", s.mainWasm)
+	mux.HandleFunc("/ws", s.socket)
+	mux.HandleFunc("/reload", s.reload)
+	if s.compile != nil {
+		mux.Handle(compilePath, s.compile)
+	}
+	mux.HandleFunc("/", s.static)
+	return noCache(mux)
+}
+
+// compilePath is where the compile endpoint is served
+const compilePath = "/compile"
+
+// env is the window.env object web/index.js and the playground read
+func (s *server) env(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	compileURL := ""
+	if s.compile != nil {
+		compileURL = compilePath
+	}
+	fmt.Fprintf(w, "window.env = {WASM_URL: %q, LIVE_PORT: %d, COMPILE_URL: %q};\n", "/main.wasm", s.livePort, compileURL)
+}
+
+func (s *server) mainWasm(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/wasm")
+	http.ServeFile(w, r, s.wasm)
+}
+
+func (s *server) socket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrade(w, r)
+	if errors.Is(err, errOrigin) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Under the lock report holds, so the page gets either the failed build
+	// or the broadcast of the next one
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failed != nil {
+		s.hub.add(conn, *s.failed)
+		return
+	}
+	s.hub.add(conn)
+}
+
+// reload rebuilds main.wasm and reloads the open pages, for editors and
+// scripts that want to trigger it: curl -X POST localhost:8080/reload
+func (s *server) reload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if output, err := s.rebuild(); err != nil {
+		http.Error(w, output, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// rebuild compiles main.wasm and tells the open pages to reload once it
+// built, or shows them the compiler output
+func (s *server) rebuild() (string, error) {
+	log.Println("building main.wasm")
+	output, err := s.builder.Build()
+	s.report(output, err)
+	return output, err
+}
+
+// changed handles files changed while serving. Go sources are rebuilt, and
+// Markdown docs pages are compiled to Go first. Anything else, like the
+// files of web/, only needs the pages reloaded.
+func (s *server) changed(files []string) {
+	log.Printf("changed: %s", strings.Join(files, ", "))
+
+	var build, generate bool
+	for _, file := range files {
+		switch path.Ext(file) {
+		case ".go", ".mod", ".sum":
+			build = true
+		case ".md":
+			build, generate = true, true
+		}
+	}
+
+	if generate {
+		if output, err := s.builder.Generate(); err != nil {
+			s.report(output, err)
+			return
+		}
+	}
+	if build {
+		s.rebuild()
+		return
+	}
+
+	// Reloading would hide the errors of a failed build
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failed == nil {
+		s.hub.Broadcast(message{Cmd: "reload"})
+	}
+}
+
+// report tells the pages about the result of a build
+func (s *server) report(output string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		log.Printf("build failed:\n%s", output)
+		s.failed = &message{Cmd: "error", Output: output}
+		s.hub.Broadcast(*s.failed)
+		return
+	}
+	s.failed = nil
+	s.hub.Broadcast(message{Cmd: "reload"})
+}
+
+// static serves the files of web/. Paths without a file extension are app
+// routes, like /docs/state, and get index.html.
+func (s *server) static(w http.ResponseWriter, r *http.Request) {
+	name := path.Clean("/" + r.URL.Path)
+	file := filepath.Join(s.web, filepath.FromSlash(name))
+
+	if info, err := os.Stat(file); err == nil && !info.IsDir() {
+		http.ServeFile(w, r, file)
+		return
+	}
+	if path.Ext(name) != "" {
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeFile(w, r, filepath.Join(s.web, "index.html"))
+}
+
+// noCache keeps the browser from holding on to an old build
+func noCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// watcher polls files for changes. The standard library has no file system
+// notifications, and polling the few hundred files of the site is cheap.
+type watcher struct {
+	root string
+
+	// paths are the files and directories to watch, relative to root
+	paths []string
+
+	interval time.Duration
+
+	// debounce is how long the files must stay unchanged before onChange
+	// runs, so saving many files at once triggers one build
+	debounce time.Duration
+
+	// skip reports the files not to watch, by path relative to root, like
+	// the ones onChange generates
+	skip func(path string) bool
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Run calls onChange with the changed files, relative to root, until the
+// process exits. Files saved while onChange runs are compared with the
+// snapshot taken before it, so they trigger it again once it returns.
+func (w *watcher) Run(onChange func(changed []string)) {
+	last := w.snapshot()
+	pending := make(map[string]bool)
+	var lastChange time.Time
+
+	for range time.Tick(w.interval) {
+		current := w.snapshot()
+		for path := range diff(last, current) {
+			pending[path] = true
+			lastChange = time.Now()
+		}
+		last = current
+
+		if len(pending) == 0 || time.Since(lastChange) < w.debounce {
+			continue
+		}
+
+		changed := make([]string, 0, len(pending))
+		for path := range pending {
+			changed = append(changed, path)
+		}
+		sort.Strings(changed)
+		clear(pending)
+
+		onChange(changed)
+	}
+}
+
+func (w *watcher) snapshot() map[string]fileState {
+	files := make(map[string]fileState)
+	for _, path := range w.paths {
+		filepath.WalkDir(filepath.Join(w.root, path), func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			name := entry.Name()
+			if entry.IsDir() {
+				if strings.HasPrefix(name, ".") && file != filepath.Join(w.root, path) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+				return nil
+			}
+			rel, err := filepath.Rel(w.root, file)
+			if err != nil {
+				return nil
+			}
+			rel = filepath.ToSlash(rel)
+			if w.skip != nil && w.skip(rel) {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return nil
+			}
+			files[rel] = fileState{modTime: info.ModTime(), size: info.Size()}
+			return nil
+		})
+	}
+	return files
+}
+
+// diff returns the files added, removed or modified between two snapshots
+func diff(before, after map[string]fileState) map[string]bool {
+	changed := make(map[string]bool)
+	for path, state := range after {
+		if previous, ok := before[path]; !ok || previous != state {
+			changed[path] = true
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed[path] = true
+		}
+	}
+	return changed
+}
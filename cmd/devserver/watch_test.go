@@ -0,0 +1,128 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func write(t *testing.T, root, name, content string) {
+	t.Helper()
+	path := filepath.Join(root, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func receive(t *testing.T, changes <-chan []string) []string {
+	t.Helper()
+	select {
+	case changed := <-changes:
+		return changed
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change reported")
+		return nil
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	root := t.TempDir()
+	write(t, root, "app/app.go", "package app")
+	write(t, root, "app/search/index_gen.go", "package search")
+	write(t, root, "web/index.css", "body {}")
+
+	w := &watcher{
+		root:     root,
+		paths:    []string{"app", "web"},
+		interval: 10 * time.Millisecond,
+		debounce: 30 * time.Millisecond,
+		skip:     generated(root, "web"),
+	}
+
+	changes := make(chan []string)
+	building := make(chan struct{})
+	first := true
+	go w.Run(func(changed []string) {
+		changes <- changed
+		if first {
+			// Stands for a build that takes a while, files saved until
+			// building is closed must not be lost
+			first = false
+			<-building
+		}
+	})
+	time.Sleep(50 * time.Millisecond)
+
+	write(t, root, "app/app.go", "package app // edited")
+	write(t, root, "web/index.css", "body { margin: 0 }")
+	if got, want := receive(t, changes), []string{"app/app.go", "web/index.css"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("first change = %q, want %q", got, want)
+	}
+
+	// Saved during the build, along with what the build writes itself
+	write(t, root, "app/pages/home/home.go", "package home")
+	write(t, root, "app/search/index_gen.go", "package search // regenerated")
+	write(t, root, "web/main.wasm", "wasm")
+	write(t, root, "web/sitemap.xml", "<urlset/>")
+	time.Sleep(100 * time.Millisecond)
+	close(building)
+
+	if got, want := receive(t, changes), []string{"app/pages/home/home.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("change during the build = %q, want %q", got, want)
+	}
+
+	if err := os.Remove(filepath.Join(root, "app/pages/home/home.go")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := receive(t, changes), []string{"app/pages/home/home.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("removal = %q, want %q", got, want)
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	root := t.TempDir()
+	write(t, root, "app/pages/docs/content/getting_started/installation.md", "# Installation")
+
+	generatedFile := generated(root, "web")
+	for file, want := range map[string]bool{
+		"web/main.wasm":                                    true,
+		"web/sitemap.xml":                                  true,
+		"app/search/index_gen.go":                          true,
+		"app/pages/docs/content/pages_gen.go":              true,
+		"app/pages/docs/getting_started/installation.go":   true,
+		"app/pages/docs/core_concepts/state_management.go": false,
+		"app/pages/docs/docs.go":                           false,
+		"app/search/search.go":                             false,
+		"web/index.css":                                    false,
+		"app/main.wasm":                                    false,
+	} {
+		if got := generatedFile(file); got != want {
+			t.Errorf("generated(%q) = %v, want %v", file, got, want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Now()
+	before := map[string]fileState{
+		"same":     {modTime: now, size: 1},
+		"modified": {modTime: now, size: 1},
+		"resized":  {modTime: now, size: 1},
+		"removed":  {modTime: now, size: 1},
+	}
+	after := map[string]fileState{
+		"same":     {modTime: now, size: 1},
+		"modified": {modTime: now.Add(time.Second), size: 1},
+		"resized":  {modTime: now, size: 2},
+		"added":    {modTime: now, size: 1},
+	}
+	want := map[string]bool{"modified": true, "resized": true, "removed": true, "added": true}
+	if got := diff(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("diff = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The server side of RFC 6455, as much of it as pushing JSON messages to
+// the page needs. Messages from the page are read and dropped.
+
+const (
+	websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	opText  = 0x1
+	opClose = 0x8
+	opPing  = 0x9
+	opPong  = 0xA
+)
+
+// hub is the set of open pages
+type hub struct {
+	mu    sync.Mutex
+	conns map[*wsConn]bool
+}
+
+func newHub() *hub {
+	return &hub{conns: make(map[*wsConn]bool)}
+}
+
+// Broadcast sends message as JSON to every open page. It only queues the
+// message, so a page that stopped reading holds up neither the caller nor
+// the other pages, and is dropped once its queue is full.
+func (h *hub) Broadcast(message any) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Println("websocket:", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn := range h.conns {
+		select {
+		case conn.send <- data:
+		default:
+			h.drop(conn)
+		}
+	}
+}
+
+// add keeps conn until the page goes away or stops taking messages. The
+// greeting messages are sent to it ahead of any broadcast.
+func (h *hub) add(conn *wsConn, greeting ...any) {
+	conn.send = make(chan []byte, sendQueue)
+	for _, message := range greeting {
+		if data, err := json.Marshal(message); err == nil {
+			conn.send <- data
+		} else {
+			log.Println("websocket:", err)
+		}
+	}
+	h.mu.Lock()
+	h.conns[conn] = true
+	h.mu.Unlock()
+
+	go func() {
+		for data := range conn.send {
+			if err := conn.write(opText, data); err != nil {
+				h.remove(conn)
+				return
+			}
+		}
+	}()
+	go func() {
+		conn.readLoop()
+		h.remove(conn)
+	}()
+}
+
+// remove drops conn if it is still open
+func (h *hub) remove(conn *wsConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.drop(conn)
+}
+
+// drop closes conn and forgets it, with h.mu held
+func (h *hub) drop(conn *wsConn) {
+	if !h.conns[conn] {
+		return
+	}
+	delete(h.conns, conn)
+	close(conn.send)
+	conn.close()
+}
+
+// sendQueue is how many messages a page may fall behind before it is
+// dropped, and writeTimeout how long a single write may take
+const (
+	sendQueue    = 16
+	writeTimeout = 10 * time.Second
+)
+
+type wsConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	// send is the queue of text messages of the hub
+	send chan []byte
+
+	mu sync.Mutex
+}
+
+// errOrigin is returned by upgrade for handshakes of pages of other sites
+var errOrigin = errors.New("websocket from another origin")
+
+// upgrade completes the websocket handshake of r. Browsers let any page
+// open a websocket to localhost, so handshakes that carry an Origin must
+// come from a page of this server.
+func upgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		return nil, errors.New("not a websocket handshake")
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || !strings.EqualFold(u.Host, r.Host) {
+			return nil, errOrigin
+		}
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, errors.New("missing Sec-WebSocket-Key")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("connection cannot be hijacked")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+	rw.WriteString("Upgrade: websocket\r\n")
+	rw.WriteString("Connection: Upgrade\r\n")
+	rw.WriteString("Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(sum[:]) + "\r\n\r\n")
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &wsConn{conn: conn, reader: rw.Reader}, nil
+}
+
+// write sends a single unmasked frame
+func (c *wsConn) write(opcode byte, payload []byte) error {
+	header := []byte{0x80 | opcode}
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n <= 0xFFFF:
+		header = append(header, 126, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header = append(header, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err := c.conn.Write(append(header, payload...))
+	return err
+}
+
+// readLoop reads frames until the page closes the connection, answering
+// pings and the closing handshake
+func (c *wsConn) readLoop() {
+	for {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return
+		}
+		switch opcode {
+		case opPing:
+			c.write(opPong, payload)
+		case opClose:
+			c.write(opClose, nil)
+			return
+		}
+	}
+}
+
+func (c *wsConn) readFrame() (byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(c.reader, head[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+
+	length := uint64(head[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > 1<<20 {
+		return 0, nil, errors.New("frame too large")
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return opcode, payload, nil
+}
+
+func (c *wsConn) close() {
+	c.conn.Close()
+}
+
+// headerContains reports whether a comma separated header has token
+func headerContains(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// masked builds a client frame, which RFC 6455 requires to be masked
+func masked(opcode byte, payload []byte) []byte {
+	mask := [4]byte{0x37, 0xfa, 0x21, 0x3d}
+	frame := []byte{0x80 | opcode}
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, 0x80|byte(n))
+	case n <= 0xFFFF:
+		frame = append(frame, 0x80|126, 0, 0)
+		binary.BigEndian.PutUint16(frame[2:], uint16(n))
+	default:
+		frame = append(frame, 0x80|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(frame[2:], uint64(n))
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	return frame
+}
+
+// dial opens a websocket to server and returns the client end, read with
+// the same frame reader as the server
+func dial(t *testing.T, server *httptest.Server) *wsConn {
+	t.Helper()
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	// The sample handshake of RFC 6455 section 1.3
+	request := "GET /ws HTTP/1.1\r\n" +
+		"Host: " + server.Listener.Addr().String() + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: keep-alive, Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := conn.Write([]byte(request)); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("handshake status = %d, want 101", response.StatusCode)
+	}
+	if got, want := response.Header.Get("Sec-WebSocket-Accept"), "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="; got != want {
+		t.Errorf("Sec-WebSocket-Accept = %q, want %q", got, want)
+	}
+	return &wsConn{conn: conn, reader: reader}
+}
+
+func TestWebsocket(t *testing.T) {
+	h := newHub()
+	added := make(chan bool, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrade(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.add(conn)
+		added <- true
+	}))
+	defer server.Close()
+
+	client := dial(t, server)
+	<-added
+
+	h.Broadcast(map[string]string{"type": "reload"})
+	opcode, payload, err := client.readFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opcode != opText || string(payload) != `{"type":"reload"}` {
+		t.Errorf("broadcast frame = %#x %q", opcode, payload)
+	}
+
+	if _, err := client.conn.Write(masked(opPing, []byte("are you there"))); err != nil {
+		t.Fatal(err)
+	}
+	opcode, payload, err = client.readFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opcode != opPong || string(payload) != "are you there" {
+		t.Errorf("answer to ping = %#x %q, want a pong with its payload", opcode, payload)
+	}
+
+	if _, err := client.conn.Write(masked(opClose, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if opcode, _, err = client.readFrame(); err != nil || opcode != opClose {
+		t.Errorf("answer to close = %#x, %v, want a close", opcode, err)
+	}
+	if _, _, err := client.readFrame(); err == nil {
+		t.Error("connection still open after the closing handshake")
+	}
+
+	// The page is gone, broadcasting to nobody must not block or fail
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		h.mu.Lock()
+		open := len(h.conns)
+		h.mu.Unlock()
+		if open == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d connections left after close", open)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	h.Broadcast(map[string]string{"type": "reload"})
+}
+
+func TestBroadcastStalled(t *testing.T) {
+	h := newHub()
+	added := make(chan bool, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrade(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.add(conn)
+		added <- true
+	}))
+	defer server.Close()
+
+	// The first page never reads, the second one does
+	dial(t, server)
+	<-added
+	reading := dial(t, server)
+	<-added
+
+	// More than the queue of the stalled page and its socket buffers take,
+	// each read by the other page before the next
+	message := map[string]string{"cmd": "error", "output": strings.Repeat("x", 512<<10)}
+	for i := range 2 * sendQueue {
+		start := time.Now()
+		h.Broadcast(message)
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Fatalf("broadcast %d took %v with a stalled page", i, elapsed)
+		}
+		if opcode, _, err := reading.readFrame(); err != nil || opcode != opText {
+			t.Fatalf("broadcast %d read with %#x, %v", i, opcode, err)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		h.mu.Lock()
+		open := len(h.conns)
+		h.mu.Unlock()
+		if open == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d connections left, want the stalled page dropped", open)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestUpgradeRejects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := upgrade(w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		header map[string]string
+	}{
+		{"plain request", nil},
+		{"no upgrade", map[string]string{"Connection": "Upgrade", "Sec-WebSocket-Key": "dGhlIHNhbXBsZSBub25jZQ=="}},
+		{"no key", map[string]string{"Connection": "Upgrade", "Upgrade": "websocket"}},
+	}
+	for _, tt := range tests {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, value := range tt.header {
+			request.Header.Set(name, value)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", tt.name, response.StatusCode)
+		}
+	}
+}
+
+func TestSocketOrigin(t *testing.T) {
+	s := &server{hub: newHub()}
+	server := httptest.NewServer(http.HandlerFunc(s.socket))
+	defer server.Close()
+
+	host := server.Listener.Addr().String()
+	tests := []struct {
+		origin string
+		want   int
+	}{
+		{"", http.StatusSwitchingProtocols},
+		{"http://" + host, http.StatusSwitchingProtocols},
+		{"http://evil.example", http.StatusForbidden},
+		{"http://" + host + ".evil.example", http.StatusForbidden},
+		{"null", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Connection", "Upgrade")
+		request.Header.Set("Upgrade", "websocket")
+		request.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		if tt.origin != "" {
+			request.Header.Set("Origin", tt.origin)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != tt.want {
+			t.Errorf("origin %q: status = %d, want %d", tt.origin, response.StatusCode, tt.want)
+		}
+	}
+}
+
+func TestSocketFailedBuild(t *testing.T) {
+	s := &server{hub: newHub(), failed: &message{Cmd: "error", Output: "main.go:1: syntax error"}}
+	server := httptest.NewServer(http.HandlerFunc(s.socket))
+	defer server.Close()
+
+	client := dial(t, server)
+	for open := 0; open == 0; {
+		time.Sleep(10 * time.Millisecond)
+		s.hub.mu.Lock()
+		open = len(s.hub.conns)
+		s.hub.mu.Unlock()
+	}
+	s.report("", nil)
+
+	for _, want := range []string{`{"cmd":"error","output":"main.go:1: syntax error"}`, `{"cmd":"reload"}`} {
+		opcode, payload, err := client.readFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if opcode != opText || string(payload) != want {
+			t.Errorf("frame = %#x %q, want %s", opcode, payload, want)
+		}
+	}
+}
+
+func TestFrameLengths(t *testing.T) {
+	for _, n := range []int{0, 125, 126, 0xFFFF, 0x10000, 70000} {
+		server, client := net.Pipe()
+		payload := bytes.Repeat([]byte{'x'}, n)
+
+		go func() {
+			(&wsConn{conn: server}).write(opText, payload)
+			server.Close()
+		}()
+		reader := &wsConn{conn: client, reader: bufio.NewReader(client)}
+		opcode, got, err := reader.readFrame()
+		if err != nil {
+			t.Errorf("%d bytes: %v", n, err)
+		} else if opcode != opText || !bytes.Equal(got, payload) {
+			t.Errorf("%d bytes: read %#x with %d bytes", n, opcode, len(got))
+		}
+		client.Close()
+
+		// And masked, the way pages send them
+		frame := masked(opText, payload)
+		reader = &wsConn{reader: bufio.NewReader(bytes.NewReader(frame))}
+		if opcode, got, err := reader.readFrame(); err != nil || opcode != opText || !bytes.Equal(got, payload) {
+			t.Errorf("masked %d bytes: read %#x with %d bytes, %v", n, opcode, len(got), err)
+		}
+	}
+
+	huge := []byte{0x80 | opText, 127, 0, 0, 0, 0, 0, 0x20, 0, 0}
+	reader := &wsConn{reader: bufio.NewReader(bytes.NewReader(huge))}
+	if _, _, err := reader.readFrame(); err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Errorf("frame of 2 MiB read with %v, want it refused", err)
+	}
+}
+
+func TestHeaderContains(t *testing.T) {
+	header := http.Header{"Connection": {"keep-alive, Upgrade"}}
+	if !headerContains(header, "Connection", "upgrade") {
+		t.Error("upgrade not found in a list")
+	}
+	if headerContains(header, "Connection", "close") || headerContains(header, "Upgrade", "websocket") {
+		t.Error("found a token that is not there")
+	}
+}
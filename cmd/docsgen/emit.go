@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+	"github.com/gofred-io/gofred-website/app/markdown/layout"
+)
+
+const generatedPrefix = "// Code generated by docsgen"
+
+// indexEntry is a line of the markdown path to content function map
+type indexEntry struct {
+	Path       string
+	ImportPath string
+	Package    string
+	Function   string
+}
+
+// emitter writes the body of a content function and remembers which
+// packages it used
+type emitter struct {
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func emitPage(source, pkg, function string, page layout.Page) ([]byte, error) {
+	e := &emitter{imports: map[string]bool{
+		`"github.com/gofred-io/gofred-website/app/components/docpage"`: true,
+		`"github.com/gofred-io/gofred/application"`:                    true,
+	}}
+
+	fmt.Fprintf(&e.buf, "func %s() application.BaseWidget {\n", function)
+	e.buf.WriteString("return docpage.Page(\n")
+	e.buf.WriteString("docpage.Header(\n")
+	fmt.Fprintf(&e.buf, "%s,\n", strconv.Quote(page.Title))
+	e.spans(page.Description)
+	e.buf.WriteString("),\n")
+
+	first := true
+	for _, element := range page.Elements {
+		switch element.Kind {
+		case layout.Section, layout.Subsection:
+			constructor, gap := "Section", 24
+			if element.Kind == layout.Subsection {
+				constructor, gap = "Subsection", 16
+			}
+			if !first {
+				e.spacer(gap)
+			}
+			fmt.Fprintf(&e.buf, "docpage.%s(\n%s,\n", constructor, strconv.Quote(element.Title))
+			e.spans(element.Description)
+			e.buf.WriteString("),\n")
+		case layout.Paragraph:
+			e.buf.WriteString("docpage.Paragraph(\n")
+			e.spans(element.Inlines)
+			e.buf.WriteString("),\n")
+		case layout.CheckList, layout.OrderedList:
+			if element.Kind == layout.OrderedList {
+				fmt.Fprintf(&e.buf, "docpage.OrderedList(\n%d,\n", element.Start)
+			} else {
+				e.buf.WriteString("docpage.CheckList(\n")
+			}
+			for _, item := range element.Items {
+				e.buf.WriteString("docpage.Spans{\n")
+				e.spans(item.Inlines)
+				e.buf.WriteString("},\n")
+			}
+			e.buf.WriteString("),\n")
+		case layout.TermList:
+			e.buf.WriteString("docpage.TermList(\n")
+			for _, item := range element.Items {
+				fmt.Fprintf(&e.buf, "docpage.Term{\nTerm: %s,\nDescription: %s,\n},\n",
+					strconv.Quote(item.Title), strconv.Quote(item.Description))
+			}
+			e.buf.WriteString("),\n")
+		case layout.NextSteps:
+			e.buf.WriteString("docpage.NextSteps(\n")
+			for _, item := range element.Items {
+				fmt.Fprintf(&e.buf, "docpage.Step{\nTitle: %s,\nDescription: %s,\nHref: %s,\n},\n",
+					strconv.Quote(item.Title), strconv.Quote(item.Description), strconv.Quote(item.Href))
+			}
+			e.buf.WriteString("),\n")
+		case layout.Code:
+			e.imports[`"github.com/gofred-io/gofred-website/app/components/codeblock"`] = true
+			e.codeBlock(element)
+		case layout.CodeGroup:
+			e.imports[`"github.com/gofred-io/gofred-website/app/components/codeblock"`] = true
+			e.buf.WriteString("codeblock.Group(\n")
+			for _, tab := range element.Tabs {
+				fmt.Fprintf(&e.buf, "codeblock.Tab{\nLabel: %s,\nBlock: ", strconv.Quote(tab.Options.Tab))
+				e.block(tab)
+				e.buf.WriteString(",\n},\n")
+			}
+			e.buf.WriteString("),\n")
+		}
+		first = false
+	}
+
+	e.buf.WriteString(")\n}\n")
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "%s from %s. DO NOT EDIT.\n\npackage %s\n\n", generatedPrefix, source, pkg)
+	writeImports(&out, e.imports)
+	out.WriteString("\n")
+	out.Write(e.buf.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func emitIndex(entries []indexEntry) ([]byte, error) {
+	imports := map[string]bool{
+		`"github.com/gofred-io/gofred/application"`: true,
+	}
+	for _, entry := range entries {
+		imports[strconv.Quote(entry.ImportPath)] = true
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "%s. DO NOT EDIT.\n\n//go:build !docs_runtime\n\npackage content\n\n", generatedPrefix)
+	writeImports(&out, imports)
+	out.WriteString("\nvar generated = map[string]func() application.BaseWidget{\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&out, "%s: %s.%s,\n", strconv.Quote(entry.Path), entry.Package, entry.Function)
+	}
+	out.WriteString("}\n")
+
+	return format.Source(out.Bytes())
+}
+
+// writeImports writes the import block with the site's packages grouped
+// before gofred's, the way the hand-written pages order them
+func writeImports(out *bytes.Buffer, imports map[string]bool) {
+	var local, gofred []string
+	for path := range imports {
+		if strings.HasPrefix(path, `"github.com/gofred-io/gofred-website/`) {
+			local = append(local, path)
+		} else {
+			gofred = append(gofred, path)
+		}
+	}
+	sort.Strings(local)
+	sort.Strings(gofred)
+
+	out.WriteString("import (\n")
+	for _, path := range local {
+		out.WriteString(path + "\n")
+	}
+	if len(local) > 0 && len(gofred) > 0 {
+		out.WriteString("\n")
+	}
+	for _, path := range gofred {
+		out.WriteString(path + "\n")
+	}
+	out.WriteString(")\n")
+}
+
+func (e *emitter) spacer(height int) {
+	e.imports[`"github.com/gofred-io/gofred/foundation/spacer"`] = true
+	fmt.Fprintf(&e.buf, "spacer.New(spacer.Height(%d)),\n", height)
+}
+
+func (e *emitter) spans(inlines []markdown.Inline) {
+	for _, inline := range inlines {
+		switch inline.Kind {
+		case markdown.InlineCode:
+			fmt.Fprintf(&e.buf, "docpage.Code(%s),\n", strconv.Quote(inline.Text))
+		case markdown.InlineLink:
+			fmt.Fprintf(&e.buf, "docpage.Link(%s, %s),\n", strconv.Quote(inline.Text), strconv.Quote(inline.Href))
+		case markdown.InlineStrong:
+			fmt.Fprintf(&e.buf, "docpage.Strong(%s),\n", strconv.Quote(inline.Text))
+		case markdown.InlineEmphasis:
+			fmt.Fprintf(&e.buf, "docpage.Emphasis(%s),\n", strconv.Quote(inline.Text))
+		default:
+			fmt.Fprintf(&e.buf, "docpage.Text(%s),\n", strconv.Quote(inline.Text))
+		}
+	}
+}
+
+// codeBlock writes a code block, with codeblock.New when the fence sets
+// nothing but the code
+func (e *emitter) codeBlock(element layout.Element) {
+	options := element.Options
+	if element.Lang == "" && options.Title == "" && !options.LineNumbers && len(options.Lines) == 0 && !options.Diff {
+		fmt.Fprintf(&e.buf, "codeblock.New(%s),\n", codeLiteral(element.Code))
+		return
+	}
+
+	e.buf.WriteString("codeblock.Build(")
+	e.block(element)
+	e.buf.WriteString("),\n")
+}
+
+// block writes the codeblock.Block literal of a code element
+func (e *emitter) block(element layout.Element) {
+	options := element.Options
+	e.buf.WriteString("codeblock.Block{\n")
+	if element.Lang != "" {
+		fmt.Fprintf(&e.buf, "Language: %s,\n", strconv.Quote(element.Lang))
+	}
+	if options.Title != "" {
+		fmt.Fprintf(&e.buf, "Title: %s,\n", strconv.Quote(options.Title))
+	}
+	if options.LineNumbers {
+		e.buf.WriteString("LineNumbers: true,\n")
+	}
+	if len(options.Lines) > 0 {
+		e.buf.WriteString("Highlight: []codeblock.Range{")
+		for i, lines := range options.Lines {
+			if i > 0 {
+				e.buf.WriteString(", ")
+			}
+			fmt.Fprintf(&e.buf, "{From: %d, To: %d}", lines.From, lines.To)
+		}
+		e.buf.WriteString("},\n")
+	}
+	if options.Diff {
+		e.buf.WriteString("Diff: true,\n")
+	}
+	fmt.Fprintf(&e.buf, "Code: %s,\n}", codeLiteral(element.Code))
+}
+
+// codeLiteral keeps code samples readable as raw strings where Go allows it
+func codeLiteral(code string) string {
+	if strings.ContainsAny(code, "`\r") {
+		return strconv.Quote(code)
+	}
+	return "`" + code + "`"
+}
@@ -0,0 +1,262 @@
+// Docsgen compiles the markdown docs pages into Go.
+//
+// Every app/pages/docs/content/<dir>/<name>.md file becomes a <Name>Content
+// function in app/pages/docs/<dir>/<name>.go that builds the page from the
+// docpage widgets, and app/pages/docs/content/pages_gen.go maps each markdown
+// path to its function. Links to site pages are checked against the router
+// and the docs registry.
+//
+// The generated files are committed so that changes to the output show up in
+// review. Run with -check to verify they are up to date without writing.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+	"github.com/gofred-io/gofred-website/app/markdown/layout"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const (
+	contentDir = "app/pages/docs/content"
+	pagesDir   = "app/pages/docs"
+	indexFile  = "pages_gen.go"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	check := flag.Bool("check", false, "report stale generated files instead of writing them")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	files, problems, err := generate(*root)
+	if err != nil {
+		fatal(err)
+	}
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+
+	stale, err := sync(*root, files, *check)
+	if err != nil {
+		fatal(err)
+	}
+	if *check && len(stale) > 0 {
+		for _, path := range stale {
+			fmt.Fprintf(os.Stderr, "%s is out of date\n", path)
+		}
+		fmt.Fprintln(os.Stderr, "run go generate ./app/pages/docs/content")
+		os.Exit(1)
+	}
+}
+
+// generate returns the generated files keyed by their path relative to root,
+// together with any broken links found on the pages
+func generate(root string) (map[string][]byte, []string, error) {
+	module, err := routes.ModulePath(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	site, err := routes.Load(root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources, err := filepath.Glob(filepath.Join(root, contentDir, "*", "*.md"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(sources)
+
+	files := make(map[string][]byte)
+	var problems []string
+	var entries []indexEntry
+
+	for _, source := range sources {
+		rel, err := filepath.Rel(filepath.Join(root, contentDir), source)
+		if err != nil {
+			return nil, nil, err
+		}
+		rel = filepath.ToSlash(rel)
+		dir, name := filepath.Split(rel)
+		dir = strings.TrimSuffix(dir, "/")
+		name = strings.TrimSuffix(name, ".md")
+
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, nil, err
+		}
+		page := layout.Build(markdown.Parse(data))
+		if page.Title == "" {
+			problems = append(problems, fmt.Sprintf("%s/%s:1: page has no level one heading", contentDir, rel))
+		}
+		for _, link := range page.Links() {
+			if problem := checkLink(site, link.Href); problem != "" {
+				problems = append(problems, fmt.Sprintf("%s/%s:%d: %s", contentDir, rel, link.Line, problem))
+			}
+		}
+		for _, element := range page.Elements {
+			code := []layout.Element{element}
+			if element.Kind == layout.CodeGroup {
+				code = element.Tabs
+			} else if element.Kind != layout.Code {
+				continue
+			}
+			for _, block := range code {
+				for _, problem := range checkCode(block) {
+					problems = append(problems, fmt.Sprintf("%s/%s:%d: %s", contentDir, rel, block.Line, problem))
+				}
+			}
+		}
+
+		function := exportedName(name) + "Content"
+		output, err := emitPage(contentDir+"/"+rel, dir, function, page)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", rel, err)
+		}
+		files[pagesDir+"/"+dir+"/"+name+".go"] = output
+		entries = append(entries, indexEntry{
+			Path:       rel,
+			ImportPath: module + "/" + pagesDir + "/" + dir,
+			Package:    dir,
+			Function:   function,
+		})
+	}
+
+	index, err := emitIndex(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	files[contentDir+"/"+indexFile] = index
+
+	return files, problems, nil
+}
+
+// checkLink reports why href does not lead anywhere, or "" if it does
+func checkLink(site *routes.Site, href string) string {
+	switch {
+	case href == "":
+		return "empty link"
+	case strings.HasPrefix(href, "#"), strings.Contains(href, "://"), strings.HasPrefix(href, "mailto:"):
+		return ""
+	case !strings.HasPrefix(href, "/"):
+		return fmt.Sprintf("relative link %q, use the route instead", href)
+	}
+
+	path, _, _ := strings.Cut(href, "#")
+	path, _, _ = strings.Cut(path, "?")
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if !site.Has(path) {
+		return fmt.Sprintf("broken link %q", href)
+	}
+	return ""
+}
+
+// checkCode reports the fence options of a code block that are not
+// understood or point past its lines
+func checkCode(element layout.Element) []string {
+	var problems []string
+	for _, option := range element.Options.Unknown {
+		problems = append(problems, fmt.Sprintf("unknown code block option %q", option))
+	}
+	lines := strings.Count(element.Code, "\n") + 1
+	for _, r := range element.Options.Lines {
+		if r.To > lines {
+			problems = append(problems, fmt.Sprintf("highlighted lines {%d-%d} past the %d lines of the code", r.From, r.To, lines))
+		}
+	}
+	return problems
+}
+
+// sync writes files that changed and removes generated files that no longer
+// have a source. With dryRun set nothing is touched. It returns the paths
+// that were, or would have been, changed.
+func sync(root string, files map[string][]byte, dryRun bool) ([]string, error) {
+	var changed []string
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		full := filepath.Join(root, path)
+		existing, err := os.ReadFile(full)
+		if err == nil && bytes.Equal(existing, files[path]) {
+			continue
+		}
+		changed = append(changed, path)
+		if dryRun {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(full, files[path], 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	generated, err := filepath.Glob(filepath.Join(root, pagesDir, "*", "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, full := range generated {
+		rel, err := filepath.Rel(root, full)
+		if err != nil {
+			return nil, err
+		}
+		rel = filepath.ToSlash(rel)
+		if _, ok := files[rel]; ok || !isGenerated(full) {
+			continue
+		}
+		changed = append(changed, rel)
+		if dryRun {
+			continue
+		}
+		if err := os.Remove(full); err != nil {
+			return nil, err
+		}
+	}
+
+	return changed, nil
+}
+
+func isGenerated(path string) bool {
+	data, err := os.ReadFile(path)
+	return err == nil && bytes.HasPrefix(data, []byte(generatedPrefix))
+}
+
+// exportedName turns a file name like quick_start into QuickStart
+func exportedName(name string) string {
+	var builder strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' }) {
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "docsgen:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+	"github.com/gofred-io/gofred-website/app/markdown/layout"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of testdata")
+
+// golden compares got with testdata/name, or writes it there with -update
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s, run go test ./cmd/docsgen -update and review the diff\n%s", path, got)
+	}
+}
+
+func TestEmitPage(t *testing.T) {
+	sources, err := filepath.Glob(filepath.Join("testdata", "*.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) == 0 {
+		t.Fatal("no testdata/*.md pages")
+	}
+
+	for _, source := range sources {
+		name := strings.TrimSuffix(filepath.Base(source), ".md")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			output, err := emitPage("testdata/"+name+".md", "guide", exportedName(name)+"Content", layout.Build(markdown.Parse(data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden(t, name+".golden", output)
+		})
+	}
+}
+
+func TestEmitIndex(t *testing.T) {
+	output, err := emitIndex([]indexEntry{
+		{Path: "guide/prose.md", ImportPath: "example.com/site/app/pages/docs/guide", Package: "guide", Function: "ProseContent"},
+		{Path: "guide/code.md", ImportPath: "example.com/site/app/pages/docs/guide", Package: "guide", Function: "CodeContent"},
+		{Path: "reference/api.md", ImportPath: "example.com/site/app/pages/docs/reference", Package: "reference", Function: "APIContent"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "index.golden", output)
+}
+
+func TestGenerateProblems(t *testing.T) {
+	files, problems, err := generate(filepath.Join("testdata", "site"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`app/pages/docs/content/guide/intro.md:5: broken link "/docs/missing"`,
+		`app/pages/docs/content/guide/intro.md:5: relative link "intro", use the route instead`,
+		`app/pages/docs/content/guide/intro.md:7: highlighted lines {4-9} past the 1 lines of the code`,
+	}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("problems:\n got %q\nwant %q", problems, want)
+	}
+
+	for _, path := range []string{"app/pages/docs/guide/intro.go", "app/pages/docs/content/pages_gen.go"} {
+		if _, ok := files[path]; !ok {
+			t.Errorf("%s not generated", path)
+		}
+	}
+}
+
+func TestCheckLink(t *testing.T) {
+	site := &routes.Site{
+		Patterns: []string{"/", "/docs/:section"},
+		DocsBase: "/docs",
+		Categories: []routes.Category{
+			{ID: "guide", Order: 1},
+		},
+		Pages: []routes.Page{
+			{Slug: "intro", Category: "guide", Order: 1, Content: "content.Page()"},
+		},
+	}
+
+	tests := []struct {
+		href string
+		want string
+	}{
+		{"/", ""},
+		{"/docs", ""},
+		{"/docs/guide", ""},
+		{"/docs/intro", ""},
+		{"/docs/intro/", ""},
+		{"/docs/intro#usage", ""},
+		{"/docs/intro?tab=go", ""},
+		{"#usage", ""},
+		{"https://gofred.io", ""},
+		{"mailto:team@gofred.io", ""},
+		{"", "empty link"},
+		{"intro", `relative link "intro", use the route instead`},
+		{"/docs/missing", `broken link "/docs/missing"`},
+		{"/blog", `broken link "/blog"`},
+	}
+	for _, tt := range tests {
+		if got := checkLink(site, tt.href); got != tt.want {
+			t.Errorf("checkLink(%q) = %q, want %q", tt.href, got, tt.want)
+		}
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	for name, want := range map[string]string{
+		"quick_start":  "QuickStart",
+		"first-app":    "FirstApp",
+		"installation": "Installation",
+	} {
+		if got := exportedName(name); got != want {
+			t.Errorf("exportedName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package app
+
+import (
+	"example.com/site/app/pages/docs"
+	"example.com/site/app/pages/home"
+
+	"github.com/gofred-io/gofred/foundation/router"
+)
+
+func routes() {
+	router.Route("/", home.New)
+	router.Route("/docs/:section", docs.New)
+}
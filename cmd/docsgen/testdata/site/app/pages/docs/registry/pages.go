@@ -0,0 +1,14 @@
+package registry
+
+import "example.com/site/app/pages/docs/content"
+
+var (
+	categories = []Category{
+		{ID: "guide", Title: "Guide", Order: 1},
+	}
+
+	pages = []Page{
+		{Slug: "intro", Title: "Intro", Category: "guide", Order: 1, Content: content.Page("guide/intro.md")},
+		{Slug: "later", Title: "Later", Category: "guide", Order: 2},
+	}
+)
@@ -0,0 +1,173 @@
+// Linkcheck reports links in the app that lead nowhere.
+//
+// The Go sources under app/ are parsed for the literal links they build:
+// arguments of link.Href, arguments of functions with a parameter named href
+// or slug, and Href fields of composite literals. The start_url, scope and
+// shortcut URLs of web/site.webmanifest are checked too. Site paths must be
+// served by the router, #fragments must name a heading of the docs page they
+// point to, and docs slugs must name a registered page or category.
+//
+// Generated docs pages are skipped, docsgen checks the Markdown they are
+// compiled from. Links built at run time, like registry.Get(slug).Href(),
+// cannot be followed and are trusted.
+//
+// Every dead link is printed as file:line and the exit status is 1.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/search"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+// ref is a link found in the sources. Slug is set for docs slugs, which
+// are checked against the registry instead of the router.
+type ref struct {
+	File   string
+	Line   int
+	Target string
+	Slug   bool
+}
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	web := flag.String("web", "web", "directory with the static assets, relative to the root")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	problems, err := deadLinks(*root, *web)
+	if err != nil {
+		fatal(err)
+	}
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stderr, problem)
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "%d dead links\n", len(problems))
+		os.Exit(1)
+	}
+}
+
+// deadLinks returns a file:line: problem line for every dead link of the
+// module at root, whose static assets are in the web directory
+func deadLinks(root, web string) ([]string, error) {
+	site, err := routes.Load(root)
+	if err != nil {
+		return nil, err
+	}
+	module, err := routes.ModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := sourceRefs(root, module)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := manifestRefs(root, web+"/site.webmanifest")
+	if err != nil {
+		return nil, err
+	}
+	refs = append(refs, manifest...)
+
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].File != refs[j].File {
+			return refs[i].File < refs[j].File
+		}
+		return refs[i].Line < refs[j].Line
+	})
+
+	c := checker{site: site, web: filepath.Join(root, web)}
+	var problems []string
+	for _, ref := range refs {
+		if problem := c.check(ref); problem != "" {
+			problems = append(problems, fmt.Sprintf("%s:%d: %s", ref.File, ref.Line, problem))
+		}
+	}
+	return problems, nil
+}
+
+type checker struct {
+	site *routes.Site
+	web  string
+}
+
+// check reports why ref does not lead anywhere, or "" if it does
+func (c checker) check(ref ref) string {
+	target := ref.Target
+	if ref.Slug {
+		if target == "" {
+			return ""
+		}
+		_, page := c.site.Lookup(target)
+		_, category := c.site.LookupCategory(target)
+		if !page && !category {
+			return fmt.Sprintf("unknown docs page %q", target)
+		}
+		return ""
+	}
+
+	switch {
+	case target == "":
+		return "empty link"
+	case strings.HasPrefix(target, "#"), strings.Contains(target, "://"), strings.HasPrefix(target, "mailto:"):
+		return ""
+	case !strings.HasPrefix(target, "/"):
+		return fmt.Sprintf("relative link %q, use the route instead", target)
+	}
+
+	page, fragment, _ := strings.Cut(target, "#")
+	page, _, _ = strings.Cut(page, "?")
+	if page != "/" {
+		page = strings.TrimSuffix(page, "/")
+	}
+
+	if path.Ext(page) != "" {
+		if _, err := os.Stat(filepath.Join(c.web, filepath.FromSlash(page))); err != nil {
+			return fmt.Sprintf("dead link %q, no such file in %s", target, filepath.Base(c.web))
+		}
+		return ""
+	}
+	if !c.site.Has(page) {
+		return fmt.Sprintf("dead link %q", target)
+	}
+	if fragment != "" && !hasAnchor(page, fragment) {
+		return fmt.Sprintf("dead link %q, %s has no heading #%s", target, page, fragment)
+	}
+	return ""
+}
+
+// hasAnchor reports whether the docs page at href has a heading with the
+// given anchor. Pages without an outline, like the home page, cannot be
+// checked and pass.
+func hasAnchor(href, anchor string) bool {
+	outline := search.Outline(href)
+	if len(outline) == 0 {
+		return true
+	}
+	for _, heading := range outline {
+		if heading.Anchor == anchor {
+			return true
+		}
+	}
+	return false
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "linkcheck:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func TestDeadLinks(t *testing.T) {
+	got, err := deadLinks(filepath.Join("testdata", "site"), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The generated routing page is not checked, and /docs/later is served
+	// even though it is still coming soon
+	want := []string{
+		`app/pages/home/home.go:14: dead link "/pricing"`,
+		`app/pages/home/home.go:24: dead link "/blog"`,
+		`app/pages/home/home.go:25: relative link "about", use the route instead`,
+		`app/pages/home/home.go:26: empty link`,
+		`app/pages/home/home.go:31: dead link "/img/missing.png", no such file in web`,
+		`app/pages/home/home.go:35: dead link "/docs/gone"`,
+		`app/pages/home/home.go:37: dead link "/terms"`,
+		`app/pages/home/home.go:40: unknown docs page "missing"`,
+		`web/site.webmanifest:12: dead link "/pricing"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("deadLinks:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestCheckAnchors(t *testing.T) {
+	// Anchors are looked up in the search index of the app, so the pages
+	// are real ones
+	c := checker{site: &routes.Site{
+		Patterns: []string{"/", "/docs/:section"},
+		DocsBase: "/docs",
+		Categories: []routes.Category{
+			{ID: "getting-started", Order: 1},
+		},
+		Pages: []routes.Page{
+			{Slug: "installation", Category: "getting-started", Order: 1, Content: "content.Page()"},
+		},
+	}}
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/docs/installation#prerequisites", ""},
+		{"/docs/installation/#verify-installation", ""},
+		{"/docs/installation#missing", `dead link "/docs/installation#missing", /docs/installation has no heading #missing`},
+		{"/#features", ""},
+		{"/docs/getting-started#anything", ""},
+	}
+	for _, tt := range tests {
+		if got := c.check(ref{Target: tt.target}); got != tt.want {
+			t.Errorf("check(%q) = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestLinkParams(t *testing.T) {
+	site := filepath.Join("testdata", "site")
+	refs, err := sourceRefs(site, "example.com/site")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slugs := map[string]bool{}
+	for _, ref := range refs {
+		if ref.Slug {
+			slugs[ref.Target] = true
+		}
+		if ref.File == "app/pages/docs/guide/routing.go" {
+			t.Errorf("link %q of a generated page checked", ref.Target)
+		}
+	}
+	if want := map[string]bool{"routing": true, "guide": true, "missing": true}; !reflect.DeepEqual(slugs, want) {
+		t.Errorf("slugs = %v, want %v", slugs, want)
+	}
+}
+
+// TestAppLinks fails when a link of the app leads nowhere
+func TestAppLinks(t *testing.T) {
+	problems, err := deadLinks(filepath.Join("..", ".."), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// manifest holds the fields of the web app manifest that are site paths
+type manifest struct {
+	StartURL  string `json:"start_url"`
+	Scope     string `json:"scope"`
+	Shortcuts []struct {
+		URL string `json:"url"`
+	} `json:"shortcuts"`
+}
+
+// manifestRefs returns the site paths of the web app manifest at file,
+// relative to root
+func manifestRefs(root, file string) ([]ref, error) {
+	data, err := os.ReadFile(filepath.Join(root, file))
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	// JSON decoding drops positions, so the lines are found by searching
+	// for the key and value
+	lines := strings.Split(string(data), "\n")
+	line := func(key, value string) int {
+		for i, text := range lines {
+			if strings.Contains(text, strconv.Quote(key)) && strings.Contains(text, strconv.Quote(value)) {
+				return i + 1
+			}
+		}
+		return 1
+	}
+
+	refs := []ref{
+		{File: file, Line: line("start_url", m.StartURL), Target: m.StartURL},
+		{File: file, Line: line("scope", m.Scope), Target: m.Scope},
+	}
+	for _, shortcut := range m.Shortcuts {
+		refs = append(refs, ref{File: file, Line: line("url", shortcut.URL), Target: shortcut.URL})
+	}
+	return refs, nil
+}
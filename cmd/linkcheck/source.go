@@ -0,0 +1,181 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	appDir     = "app"
+	linkImport = "github.com/gofred-io/gofred/foundation/link"
+)
+
+// param says what a function parameter holds
+type param int
+
+const (
+	paramOther param = iota
+	paramHref
+	paramSlug
+)
+
+type goFile struct {
+	path    string
+	pkg     string
+	ast     *ast.File
+	imports map[string]string
+}
+
+// sourceRefs returns the literal links of the app's Go sources
+func sourceRefs(root, module string) ([]ref, error) {
+	fset := token.NewFileSet()
+	var files []goFile
+
+	err := filepath.WalkDir(filepath.Join(root, appDir), func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+			return nil
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		if ast.IsGenerated(parsed) {
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		files = append(files, goFile{
+			path:    rel,
+			pkg:     module + "/" + filepath.ToSlash(filepath.Dir(rel)),
+			ast:     parsed,
+			imports: fileImports(parsed),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string][]param)
+	for _, file := range files {
+		for name, kinds := range linkParams(file.ast) {
+			params[file.pkg+"."+name] = kinds
+		}
+	}
+
+	var refs []ref
+	for _, file := range files {
+		add := func(expr ast.Expr, slug bool) {
+			if value, ok := stringLit(expr); ok {
+				refs = append(refs, ref{
+					File:   file.path,
+					Line:   fset.Position(expr.Pos()).Line,
+					Target: value,
+					Slug:   slug,
+				})
+			}
+		}
+
+		ast.Inspect(file.ast, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.CallExpr:
+				function := file.callee(node.Fun)
+				if function == linkImport+".Href" && len(node.Args) == 1 {
+					add(node.Args[0], false)
+				}
+				for i, kind := range params[function] {
+					if i < len(node.Args) && kind != paramOther {
+						add(node.Args[i], kind == paramSlug)
+					}
+				}
+			case *ast.KeyValueExpr:
+				if key, ok := node.Key.(*ast.Ident); ok && strings.EqualFold(key.Name, "href") {
+					add(node.Value, false)
+				}
+			}
+			return true
+		})
+	}
+	return refs, nil
+}
+
+// callee returns the import path qualified name of the package level
+// function fun refers to, or "" for anything else
+func (f goFile) callee(fun ast.Expr) string {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return f.pkg + "." + fun.Name
+	case *ast.SelectorExpr:
+		if pkg, ok := fun.X.(*ast.Ident); ok && f.imports[pkg.Name] != "" {
+			return f.imports[pkg.Name] + "." + fun.Sel.Name
+		}
+	}
+	return ""
+}
+
+// linkParams returns the functions of file that take links, with what each
+// of their parameters holds. Parameters named href, or ending in Href, are
+// links and parameters named slug are docs slugs.
+func linkParams(file *ast.File) map[string][]param {
+	functions := make(map[string][]param)
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Recv != nil {
+			continue
+		}
+
+		var kinds []param
+		found := false
+		for _, field := range function.Type.Params.List {
+			for _, name := range field.Names {
+				kind := paramOther
+				switch {
+				case strings.EqualFold(name.Name, "href"), strings.HasSuffix(name.Name, "Href"):
+					kind = paramHref
+				case name.Name == "slug":
+					kind = paramSlug
+				}
+				found = found || kind != paramOther
+				kinds = append(kinds, kind)
+			}
+		}
+		if found {
+			functions[function.Name.Name] = kinds
+		}
+	}
+	return functions
+}
+
+// fileImports maps the package names of a file to their import paths
+func fileImports(file *ast.File) map[string]string {
+	packages := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		packages[name] = path
+	}
+	return packages
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
@@ -0,0 +1,15 @@
+package app
+
+import (
+	"example.com/site/app/pages/docs"
+	"example.com/site/app/pages/home"
+
+	"github.com/gofred-io/gofred/foundation/router"
+)
+
+func routes() {
+	router.Route("/", home.New)
+	router.Route("/docs/:section", docs.New)
+	router.Route("/about", home.About)
+	router.NotFound(home.New)
+}
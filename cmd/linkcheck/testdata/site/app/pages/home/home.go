@@ -0,0 +1,49 @@
+package home
+
+import (
+	"github.com/gofred-io/gofred/foundation/link"
+)
+
+type card struct {
+	Title string
+	Href  string
+}
+
+var cards = []card{
+	{Title: "About", Href: "/about"},
+	{Title: "Pricing", Href: "/pricing"},
+}
+
+func New() {
+	link.Href("/")
+	link.Href("/about/")
+	link.Href("/docs")
+	link.Href("/docs/intro")
+	link.Href("/docs/guide")
+	link.Href("/docs/intro?tab=go#usage")
+	link.Href("/blog")
+	link.Href("about")
+	link.Href("")
+	link.Href("#top")
+	link.Href("https://github.com/gofred-io/gofred")
+	link.Href("mailto:team@gofred.io")
+	link.Href("/img/logo.png")
+	link.Href("/img/missing.png")
+
+	navItem("Routing", "/docs/routing", false)
+	navItem("Later", "/docs/later", false)
+	navItem("Gone", "/docs/gone", false)
+	footerLink("Docs", "/docs")
+	footerLink("Terms", "/terms")
+	docsLink("routing")
+	docsLink("guide")
+	docsLink("missing")
+}
+
+func About() {}
+
+func navItem(title, href string, external bool) {}
+
+func footerLink(title, linkHref string) {}
+
+func docsLink(slug string) {}
@@ -0,0 +1,62 @@
+// Playground serves the compile endpoint of the /playground page on its own,
+// for copies of the site not served by cmd/devserver, like the pre-rendered
+// one or the nginx container.
+//
+// POST / with the source of a program gets its WebAssembly module back, or
+// 422 with the compiler output. Point the site at it with COMPILE_URL in
+// env.js, and allow the origin of the site with -allow-origin, since pages
+// of other origins are turned away:
+//
+//	window.env = {WASM_URL: "/main.wasm", COMPILE_URL: "http://localhost:8090"}
+//
+//	go run ./cmd/playground -allow-origin http://localhost:8080
+//
+// Programs are compiled against the modules the site's go.mod requires, see
+// package internal/playground.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/gofred-io/gofred-website/internal/playground"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	addr := flag.String("addr", "localhost:8090", "address to listen on")
+	origin := flag.String("allow-origin", "", "origin of the site calling the endpoint, like http://localhost:8080, or * for any (default: the endpoint's own)")
+	timeout := flag.Duration("timeout", 0, "longest a build may take (default: the package default)")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	compiler, err := playground.New(*root)
+	if err != nil {
+		fatal(err)
+	}
+	defer compiler.Close()
+	if *timeout > 0 {
+		compiler.Timeout = *timeout
+	}
+
+	log.Printf("compiling on http://%s", *addr)
+	if err := http.ListenAndServe(*addr, &playground.Handler{Compiler: compiler, AllowOrigin: *origin}); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "playground:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,133 @@
+// Prerender writes a static copy of the site with every route rendered into
+// its index.html, for search engines and clients without JavaScript.
+//
+// The routes are rendered by the app itself: cmd/prerender/snapshot runs it
+// under Node in the jsdom page of internal/domtest, visits every route and
+// keeps the markup of #root and the styles the app added, which go into the
+// route's index.html. The page looks the way the app shows it in the light
+// theme, and web/index.js swaps it for the live app once main.wasm runs.
+// Node and the jsdom of internal/domtest/node, installed with npm, are
+// needed, see the prerender target of the Makefile.
+//
+// The output directory starts as a copy of web/ and can be served as is.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	web := flag.String("web", "web", "directory with index.html and the static assets, relative to the root")
+	out := flag.String("out", "dist", "output directory, relative to the root")
+	origin := flag.String("origin", "https://gofred.io", "origin of the canonical URLs")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	webDir := resolve(*root, *web)
+	outDir := resolve(*root, *out)
+	if rel, err := filepath.Rel(outDir, webDir); err != nil || !strings.HasPrefix(rel, "..") {
+		// The output directory is emptied first
+		fatal(fmt.Errorf("output directory %s must not contain %s", *out, *web))
+	}
+
+	shell, err := os.ReadFile(filepath.Join(webDir, "index.html"))
+	if err != nil {
+		fatal(err)
+	}
+	pages, err := collect(*root)
+	if err != nil {
+		fatal(err)
+	}
+	paths := make([]string, len(pages))
+	for i, page := range pages {
+		paths[i] = page.Path
+	}
+	snaps, err := snapshots(*root, paths)
+	if err != nil {
+		fatal(err)
+	}
+
+	if err := os.RemoveAll(outDir); err != nil {
+		fatal(err)
+	}
+	if err := copyDir(webDir, outDir); err != nil {
+		fatal(err)
+	}
+
+	for _, page := range pages {
+		output, err := render(string(shell), page, snaps[page.Path], *origin)
+		if err != nil {
+			fatal(fmt.Errorf("%s: %w", page.Path, err))
+		}
+		path := filepath.Join(outDir, filepath.FromSlash(strings.TrimPrefix(page.Path, "/")), "index.html")
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			fatal(err)
+		}
+		if err := os.WriteFile(path, output, 0o644); err != nil {
+			fatal(err)
+		}
+	}
+
+	fmt.Printf("prerender: wrote %d pages to %s\n", len(pages), *out)
+}
+
+// resolve makes path relative to root unless it is absolute
+func resolve(root, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(root, path)
+}
+
+// copyDir copies the files under src to dst
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "prerender:", err)
+	os.Exit(1)
+}
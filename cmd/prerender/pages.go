@@ -0,0 +1,68 @@
+package main
+
+import "github.com/gofred-io/gofred-website/internal/routes"
+
+// page is a route with the metadata of its index.html
+type page struct {
+	Path string
+
+	// Title and Description replace the defaults of index.html, if set
+	Title       string
+	Description string
+
+	// ComingSoon pages are kept out of search engines
+	ComingSoon bool
+}
+
+// collect returns a page for every path the router serves
+func collect(root string) ([]page, error) {
+	site, err := routes.Load(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []page
+	for _, path := range site.Paths() {
+		p := page{Path: path}
+
+		category, isCategory := docsCategory(site, path)
+		switch slug, isDocs := docsSlug(site, path); {
+		case isCategory:
+			p.Title = category.Title + " - Gofred Documentation"
+			p.Description = category.Description
+		case isDocs && slug == "":
+			p.Title = "Documentation - Gofred"
+		case isDocs:
+			docsPage, _ := site.Lookup(slug)
+			p.Title = docsPage.Title + " - Gofred Documentation"
+			p.Description = docsPage.Description
+			p.ComingSoon = !docsPage.Available()
+		}
+
+		pages = append(pages, p)
+	}
+	return pages, nil
+}
+
+// docsSlug returns the slug of a docs path, empty for the docs landing page
+func docsSlug(site *routes.Site, path string) (string, bool) {
+	if path == site.DocsBase {
+		return "", true
+	}
+	for _, p := range site.Pages {
+		if site.Href(p.Slug) == path {
+			return p.Slug, true
+		}
+	}
+	return "", false
+}
+
+// docsCategory returns the category whose overview page is at path
+func docsCategory(site *routes.Site, path string) (routes.Category, bool) {
+	for _, category := range site.Categories {
+		if site.Href(category.ID) == path {
+			return category, true
+		}
+	}
+	return routes.Category{}, false
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	titleTag        = regexp.MustCompile(`<title>[^<]*</title>`)
+	titleMeta       = regexp.MustCompile(`<meta name="title" content="[^"]*">`)
+	descriptionMeta = regexp.MustCompile(`<meta name="description" content="[^"]*">`)
+	robotsMeta      = regexp.MustCompile(`<meta name="robots" content="[^"]*">`)
+	canonicalLink   = regexp.MustCompile(`<link rel="canonical" href="[^"]*">`)
+	rootElement     = regexp.MustCompile(`<div id="root"[^>]*>`)
+)
+
+// render fills the index.html shell with the snapshot of the page. The
+// markup goes into #root, marked with data-prerender, and the styles into a
+// <style id="prerender-style"> at the end of <head>. web/index.js removes
+// both before the app renders the page again.
+func render(shell string, p page, snap snapshot, origin string) ([]byte, error) {
+	replace := func(pattern *regexp.Regexp, value string) {
+		shell = pattern.ReplaceAllLiteralString(shell, value)
+	}
+	if p.Title != "" {
+		title := html.EscapeString(p.Title)
+		replace(titleTag, "<title>"+title+"</title>")
+		replace(titleMeta, `<meta name="title" content="`+title+`">`)
+	}
+	if p.Description != "" {
+		replace(descriptionMeta, `<meta name="description" content="`+html.EscapeString(p.Description)+`">`)
+	}
+	if p.ComingSoon {
+		replace(robotsMeta, `<meta name="robots" content="noindex, follow">`)
+	}
+	replace(canonicalLink, `<link rel="canonical" href="`+html.EscapeString(strings.TrimSuffix(origin, "/")+canonicalPath(p.Path))+`">`)
+
+	if snap.Style != "" {
+		head := strings.Index(shell, "</head>")
+		if head < 0 {
+			return nil, fmt.Errorf("index.html has no </head>")
+		}
+		// "</" can't end the element early, "<\/" is the same in CSS
+		style := `<style id="prerender-style">` + strings.ReplaceAll(snap.Style, "</", `<\/`) + "</style>\n"
+		shell = shell[:head] + style + shell[head:]
+	}
+
+	location := rootElement.FindStringIndex(shell)
+	if location == nil {
+		return nil, fmt.Errorf("index.html has no #root element")
+	}
+	tag := strings.TrimSuffix(shell[location[0]:location[1]], ">") + " data-prerender>"
+	return []byte(shell[:location[0]] + tag + snap.Root + shell[location[1]:]), nil
+}
+
+func canonicalPath(path string) string {
+	if path == "/" {
+		return "/"
+	}
+	return strings.TrimSuffix(path, "/")
+}
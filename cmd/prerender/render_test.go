@@ -0,0 +1,99 @@
+package main
+
+import "testing"
+
+const shell = `<html>
+<head>
+<title>Gofred</title>
+<meta name="title" content="Gofred">
+<meta name="description" content="Build web apps with Go">
+<meta name="robots" content="index, follow">
+<link rel="canonical" href="https://gofred.io/">
+</head>
+<body>
+<div id="root" style="display: flex;"></div>
+</body>
+</html>`
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		page page
+		snap snapshot
+		want string
+	}{
+		{
+			"home",
+			page{Path: "/"},
+			snapshot{Root: `<div class="gf-column">Build web apps with Go</div>`, Style: ":root { --color-accent: #2B799B; }"},
+			`<html>
+<head>
+<title>Gofred</title>
+<meta name="title" content="Gofred">
+<meta name="description" content="Build web apps with Go">
+<meta name="robots" content="index, follow">
+<link rel="canonical" href="https://gofred.io/">
+<style id="prerender-style">:root { --color-accent: #2B799B; }</style>
+</head>
+<body>
+<div id="root" style="display: flex;" data-prerender><div class="gf-column">Build web apps with Go</div></div>
+</body>
+</html>`,
+		},
+		{
+			"docs page coming soon",
+			page{Path: "/docs/routing/", Title: "Routing & Navigation - Gofred Documentation", Description: `Pages for "paths"`, ComingSoon: true},
+			snapshot{Root: "<p>Soon</p>", Style: `.a::after { content: "</style>"; }`},
+			`<html>
+<head>
+<title>Routing &amp; Navigation - Gofred Documentation</title>
+<meta name="title" content="Routing &amp; Navigation - Gofred Documentation">
+<meta name="description" content="Pages for &#34;paths&#34;">
+<meta name="robots" content="noindex, follow">
+<link rel="canonical" href="https://gofred.io/docs/routing">
+<style id="prerender-style">.a::after { content: "<\/style>"; }</style>
+</head>
+<body>
+<div id="root" style="display: flex;" data-prerender><p>Soon</p></div>
+</body>
+</html>`,
+		},
+		{
+			"no styles",
+			page{Path: "/playground"},
+			snapshot{Root: "<p>Playground</p>"},
+			`<html>
+<head>
+<title>Gofred</title>
+<meta name="title" content="Gofred">
+<meta name="description" content="Build web apps with Go">
+<meta name="robots" content="index, follow">
+<link rel="canonical" href="https://gofred.io/playground">
+</head>
+<body>
+<div id="root" style="display: flex;" data-prerender><p>Playground</p></div>
+</body>
+</html>`,
+		},
+	}
+	for _, tt := range tests {
+		got, err := render(shell, tt.page, tt.snap, "https://gofred.io/")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s:\n got %s\nwant %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenderShell(t *testing.T) {
+	for name, shell := range map[string]string{
+		"no #root": "<html><head></head><body></body></html>",
+		"no head":  `<html><body><div id="root"></div></body></html>`,
+	} {
+		if _, err := render(shell, page{Path: "/"}, snapshot{Style: "p {}"}, "https://gofred.io"); err == nil {
+			t.Errorf("%s: rendered without an error", name)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// snapshot is what the app shows on a route, see domtest.Snapshot
+type snapshot struct {
+	Root  string `json:"root"`
+	Style string `json:"style"`
+}
+
+// snapshots renders paths with the app in jsdom: it builds
+// cmd/prerender/snapshot for GOOS=js GOARCH=wasm and runs it with
+// internal/domtest/node/run.js, whose jsdom has to be installed with npm
+func snapshots(root string, paths []string) (map[string]snapshot, error) {
+	dir, err := os.MkdirTemp("", "prerender")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	wasm := filepath.Join(dir, "snapshot.wasm")
+	build := exec.Command("go", "build", "-o", wasm, "./cmd/prerender/snapshot")
+	build.Dir = root
+	build.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	build.Stdout = os.Stderr
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return nil, fmt.Errorf("building the snapshot program: %w", err)
+	}
+
+	out := filepath.Join(dir, "snapshots.json")
+	args := append([]string{filepath.Join("internal", "domtest", "node", "run.js"), wasm, "-out", out}, paths...)
+	run := exec.Command("node", args...)
+	run.Dir = root
+	run.Stdout = os.Stderr
+	run.Stderr = os.Stderr
+	if err := run.Run(); err != nil {
+		return nil, fmt.Errorf("rendering the routes: %w", err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]snapshot
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("reading the snapshots: %w", err)
+	}
+	for _, path := range paths {
+		if _, ok := result[path]; !ok {
+			return nil, fmt.Errorf("no snapshot of %s", path)
+		}
+	}
+	return result, nil
+}
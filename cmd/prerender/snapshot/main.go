@@ -0,0 +1,52 @@
+//go:build js && wasm
+
+// Snapshot renders routes of the app and writes what they show, for
+// cmd/prerender. It runs under Node through internal/domtest/node/run.js,
+// which loads web/index.html into jsdom as the page:
+//
+//	node internal/domtest/node/run.js snapshot.wasm -out snapshots.json / /docs
+//
+// The output is a JSON object of a domtest.Snapshot per path.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gofred-io/gofred-website/app"
+	"github.com/gofred-io/gofred-website/internal/domtest"
+)
+
+func main() {
+	out := flag.String("out", "", "file to write the snapshots to")
+	flag.Parse()
+	if *out == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot -out file path...")
+		os.Exit(2)
+	}
+
+	domtest.Start(app.New())
+
+	snapshots := make(map[string]domtest.Snapshot)
+	for _, path := range flag.Args() {
+		if err := domtest.Open(path); err != nil {
+			fatal(err)
+		}
+		snapshots[path] = domtest.Capture()
+	}
+
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		fatal(err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "snapshot:", err)
+	os.Exit(1)
+}
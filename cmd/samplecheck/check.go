@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// gofredModule is the module the samples are written against. Its version
+// is the one go.mod requires.
+const gofredModule = "github.com/gofred-io/gofred"
+
+// fragmentStd are the standard packages fragments may use without
+// importing them
+var fragmentStd = []string{"errors", "fmt", "math", "math/rand", "sort", "strconv", "strings", "time"}
+
+// checker type-checks samples against the export data of the packages they
+// import
+type checker struct {
+	fset     *token.FileSet
+	importer types.Importer
+
+	// packages maps the names fragments use, like "text", to import paths
+	packages map[string]string
+
+	// placeholders are the imports of fragments that stand for the
+	// reader's own packages, like "your-app/store"
+	placeholders map[string]bool
+}
+
+// listedPackage is a package printed by go list -json
+type listedPackage struct {
+	ImportPath string
+	Name       string
+	Export     string
+	Error      *struct{ Err string }
+}
+
+// newChecker builds the packages of gofred, fragmentStd and the imports of
+// samples for js/wasm with go list, in root so go.mod picks the gofred
+// version
+func newChecker(root string, samples []sample) (*checker, error) {
+	patterns := map[string]bool{gofredModule + "/...": true}
+	for _, path := range fragmentStd {
+		patterns[path] = true
+	}
+	fset := token.NewFileSet()
+	for _, s := range samples {
+		code := s.Code
+		if s.Fragment {
+			code = "package main\n" + code
+		}
+		// Samples that don't parse are reported by check
+		file, err := parser.ParseFile(fset, "", code, parser.ImportsOnly)
+		if err != nil {
+			continue
+		}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if !strings.HasPrefix(path, gofredModule+"/") {
+				patterns[path] = true
+			}
+		}
+	}
+	args := []string{"list", "-e", "-export", "-deps", "-json=ImportPath,Name,Export,Error"}
+	for pattern := range patterns {
+		args = append(args, pattern)
+	}
+	sort.Strings(args[5:])
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = root
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w\n%s", err, stderr.Bytes())
+	}
+
+	exports := make(map[string]string)
+	packages := make(map[string]string)
+	placeholders := make(map[string]bool)
+	built := 0
+	var failure string
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var p listedPackage
+		if err := decoder.Decode(&p); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if p.Error != nil && failure == "" && strings.HasPrefix(p.ImportPath, gofredModule) {
+			failure = p.Error.Err
+		}
+		// Paths without a dot in their first element that are not in the
+		// standard library are made up, like "your-app/store"
+		first, _, _ := strings.Cut(p.ImportPath, "/")
+		if p.Error != nil && !strings.Contains(first, ".") && !strings.HasPrefix(p.ImportPath, gofredModule) {
+			placeholders[p.ImportPath] = true
+		}
+		if p.Error != nil || p.Export == "" {
+			continue
+		}
+		exports[p.ImportPath] = p.Export
+		if strings.HasPrefix(p.ImportPath, gofredModule+"/") {
+			built++
+		}
+
+		// Names used by several packages go to the shortest path. Packages
+		// like icon_data are also known by the name they are imported as,
+		// icondata.
+		if strings.HasPrefix(p.ImportPath, gofredModule+"/") || isFragmentStd(p.ImportPath) {
+			for _, name := range []string{p.Name, strings.ReplaceAll(p.Name, "_", "")} {
+				if other, ok := packages[name]; !ok || len(p.ImportPath) < len(other) {
+					packages[name] = p.ImportPath
+				}
+			}
+		}
+	}
+	if built == 0 {
+		if failure == "" {
+			failure = stderr.String()
+		}
+		return nil, fmt.Errorf("no packages of %s could be built: %s", gofredModule, failure)
+	}
+
+	lookup := func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("package %q not found", path)
+		}
+		return os.Open(export)
+	}
+	return &checker{
+		fset:         fset,
+		importer:     importer.ForCompiler(fset, "gc", lookup),
+		packages:     packages,
+		placeholders: placeholders,
+	}, nil
+}
+
+func isFragmentStd(path string) bool {
+	for _, std := range fragmentStd {
+		if path == std {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns the errors of s as file:line:column: message, with the
+// positions of the sample's file. Fragments become a file of declarations
+// or, when they don't parse as one, the body of a function, importing the
+// packages they use. Unused variables and imports, names used without a
+// declaration and imports of made up packages are allowed in fragments.
+func (c *checker) check(s sample) []string {
+	// The file is parsed under its base name, a relative file in a line
+	// directive is taken as relative to the directory of the file parsed
+	name := path.Base(s.File)
+	position := fmt.Sprintf("//line %s:%d:%d\n", s.File, s.Line, s.Column)
+	var file *ast.File
+	var err error
+	if !s.Fragment {
+		file, err = parser.ParseFile(c.fset, name, position+s.Code, 0)
+	} else {
+		declarations := func(code string) string {
+			return "package main\n" + position + code
+		}
+		statements := func(code string) string {
+			return "package main\nfunc _() {\n" + position + code + "\n}\n"
+		}
+		wrap := declarations
+		file, err = parser.ParseFile(c.fset, name, wrap(s.Code), 0)
+		if err != nil {
+			// Broken fragments report the errors of the form that parsed
+			// further
+			body, bodyErr := parser.ParseFile(c.fset, name, statements(s.Code), 0)
+			if bodyErr == nil || firstError(bodyErr).Offset > firstError(err).Offset {
+				wrap, file, err = statements, body, bodyErr
+			}
+		}
+		if err == nil {
+			file, err = parser.ParseFile(c.fset, name, c.withImports(wrap(s.Code), file), 0)
+		}
+	}
+	if err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) {
+			var problems []string
+			for _, e := range list {
+				problems = append(problems, e.Error())
+			}
+			return problems
+		}
+		return []string{err.Error()}
+	}
+
+	qualifiers := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				qualifiers[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	var problems []string
+	conf := types.Config{
+		Importer: c.importer,
+		Sizes:    types.SizesFor("gc", "wasm"),
+		Error: func(err error) {
+			if e, ok := err.(types.Error); ok && s.Fragment && (e.Soft || placeholder(e.Msg, qualifiers) || c.placeholderImport(e.Msg)) {
+				return
+			}
+			problems = append(problems, err.Error())
+		},
+	}
+	conf.Check("main", c.fset, []*ast.File{file}, nil)
+	return problems
+}
+
+// placeholder reports whether msg is about a name a fragment uses without
+// declaring it, like content in container.New(content), which the rest of
+// the reader's program has. Names used as pkg.Name are packages the
+// checker doesn't know, those are reported.
+func placeholder(msg string, qualifiers map[string]bool) bool {
+	name, ok := strings.CutPrefix(msg, "undefined: ")
+	return ok && token.IsIdentifier(name) && !qualifiers[name]
+}
+
+// placeholderImport reports whether msg is the failed import of a package
+// of the reader's. The names it declares are not checked.
+func (c *checker) placeholderImport(msg string) bool {
+	rest, ok := strings.CutPrefix(msg, "could not import ")
+	path, _, _ := strings.Cut(rest, " ")
+	return ok && c.placeholders[path]
+}
+
+// firstError returns the position of the first parse error in err
+func firstError(err error) token.Position {
+	var list scanner.ErrorList
+	if errors.As(err, &list) && len(list) > 0 {
+		return list[0].Pos
+	}
+	return token.Position{}
+}
+
+// withImports adds the imports of the package names file uses to source,
+// after its package clause so the line directive keeps the positions.
+// Names the fragment imports itself are left to its imports.
+func (c *checker) withImports(source string, file *ast.File) string {
+	imported := make(map[string]bool)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imported[name] = true
+		imported[path] = true
+	}
+
+	// Imports are named, icon_data is used as icondata
+	used := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && c.packages[pkg.Name] != "" && !imported[pkg.Name] && !imported[c.packages[pkg.Name]] {
+				used[pkg.Name] = c.packages[pkg.Name]
+			}
+		}
+		return true
+	})
+	if len(used) == 0 {
+		return source
+	}
+
+	specs := make([]string, 0, len(used))
+	for name, path := range used {
+		specs = append(specs, name+" "+strconv.Quote(path))
+	}
+	sort.Strings(specs)
+	clause, rest, _ := strings.Cut(source, "\n")
+	return clause + "\nimport (" + strings.Join(specs, "; ") + ")\n" + rest
+}
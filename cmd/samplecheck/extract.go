@@ -0,0 +1,217 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/highlight"
+	"github.com/gofred-io/gofred-website/app/markdown"
+)
+
+const (
+	appDir     = "app"
+	contentDir = "app/pages/docs/content"
+
+	// directive marks the codeblock call on the next line as a fragment
+	directive = "//samplecheck:fragment"
+)
+
+// sample is a Go code sample. Line and Column are where its code starts in
+// the file.
+type sample struct {
+	File     string
+	Line     int
+	Column   int
+	Code     string
+	Fragment bool
+}
+
+// isProgram reports whether code is a complete program, a file starting
+// with a package clause after its comments
+func isProgram(code string) bool {
+	for _, line := range strings.Split(code, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		return strings.HasPrefix(line, "package ")
+	}
+	return false
+}
+
+// goSamples returns the samples of the codeblock.New and codeblock.Build
+// calls in the app's Go sources. Generated docs pages are skipped, their
+// Markdown is read instead.
+func goSamples(root, module string) ([]sample, error) {
+	codeblockImport := module + "/app/components/codeblock"
+	fset := token.NewFileSet()
+	var samples []sample
+
+	err := filepath.WalkDir(filepath.Join(root, appDir), func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+			return nil
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		if ast.IsGenerated(parsed) {
+			return nil
+		}
+		name := ""
+		for _, spec := range parsed.Imports {
+			if path, _ := strconv.Unquote(spec.Path.Value); path == codeblockImport {
+				name = "codeblock"
+				if spec.Name != nil {
+					name = spec.Name.Name
+				}
+			}
+		}
+		if name == "" {
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+
+		marked := make(map[int]bool)
+		for _, group := range parsed.Comments {
+			for _, comment := range group.List {
+				if comment.Text == directive {
+					marked[fset.Position(comment.End()).Line+1] = true
+				}
+			}
+		}
+
+		ast.Inspect(parsed, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != name {
+				return true
+			}
+			var code ast.Expr
+			switch sel.Sel.Name {
+			case "New":
+				code = call.Args[0]
+			case "Build":
+				code = goBlockCode(call.Args[0])
+			}
+			lit, ok := code.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			text, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+
+			program := isProgram(text)
+			fragment := marked[fset.Position(call.Pos()).Line] && !program
+			if program || fragment {
+				// The code starts after the opening quote
+				start := fset.Position(lit.Pos())
+				samples = append(samples, sample{
+					File:     filepath.ToSlash(rel),
+					Line:     start.Line,
+					Column:   start.Column + 1,
+					Code:     text,
+					Fragment: fragment,
+				})
+			}
+			return true
+		})
+		return nil
+	})
+	return samples, err
+}
+
+// goBlockCode returns the Code of a codeblock.Block literal in Go, or nil
+// for blocks in other languages and diffs, which are not compiled
+func goBlockCode(expr ast.Expr) ast.Expr {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var code ast.Expr
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, _ := kv.Key.(*ast.Ident)
+		if key == nil {
+			continue
+		}
+		switch key.Name {
+		case "Code":
+			code = kv.Value
+		case "Language":
+			if value, ok := kv.Value.(*ast.BasicLit); ok {
+				if language, _ := strconv.Unquote(value.Value); language != "" && highlight.Language(language) != highlight.Go {
+					return nil
+				}
+			}
+		case "Diff":
+			if value, ok := kv.Value.(*ast.Ident); ok && value.Name == "true" {
+				return nil
+			}
+		}
+	}
+	return code
+}
+
+// markdownSamples returns the Go code blocks of the Markdown docs pages that
+// are complete programs or marked with the check option
+func markdownSamples(root string) ([]sample, error) {
+	sources, err := filepath.Glob(filepath.Join(root, contentDir, "*", "*.md"))
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []sample
+	for _, source := range sources {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(root, source)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range markdown.Parse(data).Blocks {
+			if block.Kind != markdown.BlockCode || highlight.Language(block.Lang) != highlight.Go {
+				continue
+			}
+			options := markdown.ParseCodeOptions(block.Info)
+			program := isProgram(block.Code)
+			if options.Diff || !options.Check && !program {
+				continue
+			}
+			samples = append(samples, sample{
+				File: filepath.ToSlash(rel),
+				// block.Line is the line of the opening fence
+				Line:     block.Line + 1,
+				Column:   1,
+				Code:     block.Code,
+				Fragment: !program,
+			})
+		}
+	}
+	return samples, nil
+}
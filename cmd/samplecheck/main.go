@@ -0,0 +1,99 @@
+// Samplecheck compiles the Go code samples of the docs against the gofred
+// version go.mod requires, so they don't drift from its API.
+//
+// Samples are the code of codeblock.New and codeblock.Build calls in the
+// app's Go sources and the go code blocks of the Markdown docs pages.
+// Complete programs, starting with a package clause, are always compiled.
+// Fragments are compiled when they are marked, with a
+//
+//	//samplecheck:fragment
+//
+// comment on the line before the call in Go, or the check fence option in
+// Markdown. A fragment is compiled as declarations or, when it isn't one,
+// as the body of a function, with the gofred and common standard packages
+// it names imported. Variables and imports it doesn't use are allowed, and
+// so are names it uses without declaring them, like content or User, which
+// stand for the reader's own code, as are imports of made up packages like
+// your-app/store. Unknown gofred and standard packages are still errors.
+// Diffs and samples in other languages are skipped.
+//
+// The packages are built for js/wasm with go list, which needs the gofred
+// module in the module cache or a network to download it from. Every error
+// is printed as file:line:column with the position in the docs source and
+// the exit status is 1.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	verbose := flag.Bool("v", false, "list the samples checked")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+	module, err := routes.ModulePath(*root)
+	if err != nil {
+		fatal(err)
+	}
+
+	samples, err := goSamples(*root, module)
+	if err != nil {
+		fatal(err)
+	}
+	fromMarkdown, err := markdownSamples(*root)
+	if err != nil {
+		fatal(err)
+	}
+	samples = append(samples, fromMarkdown...)
+	sort.SliceStable(samples, func(i, j int) bool {
+		if samples[i].File != samples[j].File {
+			return samples[i].File < samples[j].File
+		}
+		return samples[i].Line < samples[j].Line
+	})
+
+	c, err := newChecker(*root, samples)
+	if err != nil {
+		fatal(err)
+	}
+
+	broken := 0
+	for _, s := range samples {
+		problems := c.check(s)
+		if *verbose {
+			kind := "program"
+			if s.Fragment {
+				kind = "fragment"
+			}
+			fmt.Printf("%s:%d: %s, %d errors\n", s.File, s.Line, kind, len(problems))
+		}
+		for _, problem := range problems {
+			fmt.Fprintln(os.Stderr, problem)
+		}
+		if len(problems) > 0 {
+			broken++
+		}
+	}
+	if broken > 0 {
+		fmt.Fprintf(os.Stderr, "%d of %d samples don't compile\n", broken, len(samples))
+		os.Exit(1)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "samplecheck:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"go/importer"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGoSamples(t *testing.T) {
+	got, err := goSamples(filepath.Join("testdata", "site"), "example.com/site")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unmarked fragments, other languages, diffs and generated pages are
+	// skipped, and so are files without the codeblock import
+	want := []sample{
+		{File: "app/pages/docs/guide/widgets.go", Line: 8, Column: 10, Code: "package main\n\nfunc main() {}"},
+		{File: "app/pages/docs/guide/widgets.go", Line: 13, Column: 10, Code: `text.New("Hello")`, Fragment: true},
+		{File: "app/pages/docs/guide/widgets.go", Line: 18, Column: 27, Code: `button.New(text.New("Go"))`, Fragment: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("goSamples:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestMarkdownSamples(t *testing.T) {
+	got, err := markdownSamples(filepath.Join("testdata", "site"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []sample{
+		{File: "app/pages/docs/content/guide/routing.md", Line: 6, Column: 1, Code: "package main\n\nfunc main() {}"},
+		{File: "app/pages/docs/content/guide/routing.md", Line: 14, Column: 1, Code: `router.Route("/", home)`, Fragment: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("markdownSamples:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	// The standard library stands in for gofred, which newChecker builds
+	// with go list
+	fset := token.NewFileSet()
+	c := &checker{
+		fset:         fset,
+		importer:     importer.ForCompiler(fset, "gc", nil),
+		packages:     map[string]string{"fmt": "fmt", "strings": "strings", "utf8": "unicode/utf8"},
+		placeholders: map[string]bool{"your-app/store": true},
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		fragment bool
+		want     []string
+	}{
+		{
+			"program", "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(1) }", false, nil,
+		},
+		{
+			"program with an unused import", "package main\n\nimport \"fmt\"\n\nfunc main() {}", false,
+			[]string{`docs/page.go:12:8: "fmt" imported and not used`},
+		},
+		{
+			"program missing an import", "package main\n\nfunc main() { fmt.Println(1) }", false,
+			[]string{"docs/page.go:12:15: undefined: fmt"},
+		},
+		{
+			"statements", "s := strings.Repeat(\"a\", 3)\nfmt.Println(s)", true, nil,
+		},
+		{
+			"declarations", "func greet(name string) string {\n    return fmt.Sprintf(\"Hi %s\", name)\n}", true, nil,
+		},
+		{
+			"package imported as another name", "n := utf8.RuneLen('x')", true, nil,
+		},
+		{
+			"fragment with its own imports", "import \"strings\"\n\nvar s = strings.ToUpper(\"a\")", true, nil,
+		},
+		{
+			"unused variable", "x := 1", true, nil,
+		},
+		{
+			"placeholders", "func page() string {\n    return render(content, User{})\n}", true, nil,
+		},
+		{
+			"placeholder import", "import \"your-app/store\"\n\nvar n = store.GetNotifications()", true, nil,
+		},
+		{
+			"unknown package", "icons.New()", true,
+			[]string{"docs/page.go:10:5: undefined: icons"},
+		},
+		{
+			"wrong arguments", "fmt.Println(1)\ns := strings.Repeat(\"a\")", true,
+			[]string{`docs/page.go:11:24: not enough arguments in call to strings.Repeat` + "\n\thave (string)\n\twant (string, int)"},
+		},
+		{
+			"syntax error", "x := (1", true,
+			[]string{"docs/page.go:10:12: expected ')', found newline"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sample{File: "docs/page.go", Line: 10, Column: 5, Code: tt.code, Fragment: tt.fragment}
+			if got := c.check(s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("check:\n got %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsProgram(t *testing.T) {
+	for code, want := range map[string]bool{
+		"package main\n":                  true,
+		"// main.go\n\npackage store\n":   true,
+		"text.New(\"package main\")":      false,
+		"// package main is the entry\nx": false,
+		"":                                false,
+	} {
+		if got := isProgram(code); got != want {
+			t.Errorf("isProgram(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestPlaceholder(t *testing.T) {
+	qualifiers := map[string]bool{"icondata": true}
+	for msg, want := range map[string]bool{
+		"undefined: content":        true,
+		"undefined: icondata":       false,
+		"undefined: text.Missing":   false,
+		"cannot use x as int value": false,
+	} {
+		if got := placeholder(msg, qualifiers); got != want {
+			t.Errorf("placeholder(%q) = %v, want %v", msg, got, want)
+		}
+	}
+}
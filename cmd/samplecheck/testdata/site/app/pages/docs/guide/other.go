@@ -0,0 +1,6 @@
+package guide
+
+func Other() {
+	//samplecheck:fragment
+	codeblock.New(`without the import this is not a code block`)
+}
@@ -0,0 +1,27 @@
+package guide
+
+import (
+	cb "example.com/site/app/components/codeblock"
+)
+
+func Page() {
+	cb.New(`package main
+
+func main() {}`)
+
+	//samplecheck:fragment
+	cb.New(`text.New("Hello")`)
+
+	cb.New(`text.New("not marked")`)
+
+	//samplecheck:fragment
+	cb.Build(cb.Block{Code: `button.New(text.New("Go"))`, Language: "go"})
+
+	//samplecheck:fragment
+	cb.Build(cb.Block{Code: `go build ./...`, Language: "bash"})
+
+	//samplecheck:fragment
+	cb.Build(cb.Block{Code: `+text.New("added")`, Diff: true})
+
+	cb.New(code)
+}
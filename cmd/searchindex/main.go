@@ -0,0 +1,148 @@
+// Searchindex writes the docs search index to app/search/index_gen.go.
+//
+// Every page in the docs registry that has content is split into one
+// document per heading, as read by internal/pagetext.
+//
+// Run with -check to verify the committed index is up to date.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/search"
+	"github.com/gofred-io/gofred-website/internal/pagetext"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const (
+	outputFile = "app/search/index_gen.go"
+)
+
+// document mirrors search.Document
+type document struct {
+	Href    string
+	Page    string
+	Section string
+	Anchor  string
+	Level   int
+	Text    []string
+	Code    []string
+}
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	check := flag.Bool("check", false, "report a stale index instead of writing it")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	docs, err := collect(*root)
+	if err != nil {
+		fatal(err)
+	}
+	output, err := emit(docs)
+	if err != nil {
+		fatal(err)
+	}
+
+	path := filepath.Join(*root, outputFile)
+	existing, _ := os.ReadFile(path)
+	if bytes.Equal(existing, output) {
+		return
+	}
+	if *check {
+		fmt.Fprintf(os.Stderr, "%s is out of date, run go generate ./app/search\n", outputFile)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(path, output, 0o644); err != nil {
+		fatal(err)
+	}
+}
+
+// collect returns the documents of every available page in sidebar order
+func collect(root string) ([]document, error) {
+	site, err := routes.Load(root)
+	if err != nil {
+		return nil, err
+	}
+	module, err := routes.ModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []document
+	sections := site.Sections()
+	for _, category := range site.Categories {
+		for _, page := range sections[category.ID] {
+			if !page.Available() {
+				continue
+			}
+
+			sections, err := pagetext.Page(root, module, site, page)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", page.Slug, err)
+			}
+
+			anchors := search.Anchors{}
+			for _, section := range sections {
+				doc := document{
+					Href:    site.Href(page.Slug),
+					Page:    page.Title,
+					Section: section.Title,
+					Level:   section.Level,
+					Text:    section.Text,
+					Code:    section.Code,
+				}
+				if doc.Section != "" {
+					doc.Anchor = anchors.Next(doc.Section)
+				}
+				docs = append(docs, doc)
+			}
+		}
+	}
+	return docs, nil
+}
+
+func emit(docs []document) ([]byte, error) {
+	var out bytes.Buffer
+	out.WriteString("// Code generated by searchindex. DO NOT EDIT.\n\npackage search\n\n")
+	out.WriteString("var documents = []Document{\n")
+	for _, doc := range docs {
+		out.WriteString("{\n")
+		fmt.Fprintf(&out, "Href: %s,\n", strconv.Quote(doc.Href))
+		fmt.Fprintf(&out, "Page: %s,\n", strconv.Quote(doc.Page))
+		if doc.Section != "" {
+			fmt.Fprintf(&out, "Section: %s,\n", strconv.Quote(doc.Section))
+			fmt.Fprintf(&out, "Anchor: %s,\n", strconv.Quote(doc.Anchor))
+			fmt.Fprintf(&out, "Level: %d,\n", doc.Level)
+		}
+		if len(doc.Text) > 0 {
+			fmt.Fprintf(&out, "Text: %s,\n", strconv.Quote(strings.Join(doc.Text, " ")))
+		}
+		if len(doc.Code) > 0 {
+			fmt.Fprintf(&out, "Code: %s,\n", strconv.Quote(strings.Join(doc.Code, "\n")))
+		}
+		out.WriteString("},\n")
+	}
+	out.WriteString("}\n")
+
+	return format.Source(out.Bytes())
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "searchindex:", err)
+	os.Exit(1)
+}
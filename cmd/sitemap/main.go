@@ -0,0 +1,244 @@
+// Sitemap writes web/sitemap.xml from the routes of the app.
+//
+// Every path the router serves is listed except docs pages that are still
+// coming soon. Priorities drop with the depth of the path and lastmod is the
+// date of the last commit touching the source of the page, or today for
+// sources with uncommitted changes.
+//
+// Run with -check to verify the committed sitemap lists exactly the routes
+// that should be in it. Dates are not compared, they depend on the checkout.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofred-io/gofred-website/internal/pagetext"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const (
+	outputFile = "web/sitemap.xml"
+)
+
+type urlSet struct {
+	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []url    `xml:"url"`
+}
+
+type url struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq"`
+	Priority   string `xml:"priority"`
+}
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	origin := flag.String("origin", "https://gofred.io", "origin of the listed URLs")
+	check := flag.Bool("check", false, "report routes missing from the sitemap instead of writing it")
+	flag.Parse()
+
+	if *root == "" {
+		dir, err := routes.FindRoot(".")
+		if err != nil {
+			fatal(err)
+		}
+		*root = dir
+	}
+
+	urls, err := collect(*root, strings.TrimSuffix(*origin, "/"))
+	if err != nil {
+		fatal(err)
+	}
+	path := filepath.Join(*root, outputFile)
+
+	if *check {
+		existing, err := os.ReadFile(path)
+		if err != nil {
+			fatal(err)
+		}
+		if problems := compare(existing, urls); len(problems) > 0 {
+			for _, problem := range problems {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", outputFile, problem)
+			}
+			fmt.Fprintln(os.Stderr, "run go run ./cmd/sitemap to update it")
+			os.Exit(1)
+		}
+		return
+	}
+
+	output, err := emit(urls)
+	if err != nil {
+		fatal(err)
+	}
+	if err := os.WriteFile(path, output, 0o644); err != nil {
+		fatal(err)
+	}
+}
+
+// collect returns the entries of every path that belongs in the sitemap
+func collect(root, origin string) ([]url, error) {
+	site, err := routes.Load(root)
+	if err != nil {
+		return nil, err
+	}
+	module, err := routes.ModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []url
+	for _, path := range site.Paths() {
+		source, ok, err := sourceOf(root, module, site, path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if !ok {
+			continue
+		}
+
+		depth := strings.Count(strings.Trim(path, "/"), "/") + 1
+		if path == "/" {
+			depth = 0
+		}
+		changeFreq := "weekly"
+		if depth > 1 {
+			changeFreq = "monthly"
+		}
+
+		urls = append(urls, url{
+			Loc:        origin + path,
+			LastMod:    lastModified(root, source),
+			ChangeFreq: changeFreq,
+			Priority:   fmt.Sprintf("%.1f", max(1.0-0.1*float64(depth), 0.1)),
+		})
+	}
+	return urls, nil
+}
+
+// sourceOf returns the file a path is built from. ok is false for paths left
+// out of the sitemap.
+func sourceOf(root, module string, site *routes.Site, path string) (string, bool, error) {
+	for _, page := range site.Pages {
+		if site.Href(page.Slug) != path {
+			continue
+		}
+		if !page.Available() {
+			return "", false, nil
+		}
+		source, err := pagetext.Source(root, module, site, page)
+		return source, err == nil, err
+	}
+
+	// Category overview pages are built from the registry
+	for _, category := range site.Categories {
+		if site.Href(category.ID) == path {
+			return routes.RegistryFile, true, nil
+		}
+	}
+
+	// The docs landing page is built by the docs route's handler, the first
+	// in pattern order when there are several
+	handler, ok := site.Handlers[path]
+	if !ok && path == site.DocsBase {
+		for _, pattern := range slices.Sorted(maps.Keys(site.Handlers)) {
+			if strings.HasPrefix(pattern, site.DocsBase+"/:") {
+				handler, ok = site.Handlers[pattern], true
+				break
+			}
+		}
+	}
+	if !ok {
+		return "", true, nil
+	}
+	dir, ok := handler.Dir(module)
+	if !ok {
+		return "", true, nil
+	}
+	source, err := pagetext.DeclFile(root, dir, handler.Name)
+	return source, err == nil, err
+}
+
+// lastModified returns the date of the last change to source
+func lastModified(root, source string) string {
+	if source == "" {
+		return ""
+	}
+
+	status, err := git(root, "status", "--porcelain", "--", source)
+	if err != nil {
+		return ""
+	}
+	if status != "" {
+		return time.Now().Format(time.DateOnly)
+	}
+	date, _ := git(root, "log", "-1", "--format=%cs", "--", source)
+	return date
+}
+
+func git(root string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = root
+	output, err := cmd.Output()
+	return strings.TrimSpace(string(output)), err
+}
+
+// compare lists the differences between the locations of a sitemap and urls
+func compare(existing []byte, urls []url) []string {
+	var set urlSet
+	if err := xml.Unmarshal(existing, &set); err != nil {
+		return []string{err.Error()}
+	}
+
+	listed := make(map[string]bool)
+	for _, u := range set.URLs {
+		listed[u.Loc] = true
+	}
+
+	var problems []string
+	for _, u := range urls {
+		if !listed[u.Loc] {
+			problems = append(problems, "missing route "+u.Loc)
+		}
+		delete(listed, u.Loc)
+	}
+	var extra []string
+	for loc := range listed {
+		extra = append(extra, loc)
+	}
+	sort.Strings(extra)
+	for _, loc := range extra {
+		problems = append(problems, "lists "+loc+", which is not a route or still coming soon")
+	}
+	return problems
+}
+
+func emit(urls []url) ([]byte, error) {
+	var out bytes.Buffer
+	out.WriteString(xml.Header)
+	out.WriteString("<!-- Code generated by cmd/sitemap. DO NOT EDIT. -->\n")
+
+	encoder := xml.NewEncoder(&out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(urlSet{URLs: urls}); err != nil {
+		return nil, err
+	}
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "sitemap:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const origin = "https://gofred.io"
+
+func TestCollect(t *testing.T) {
+	urls, err := collect(filepath.Join("testdata", "site"), origin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type entry struct {
+		Loc        string
+		ChangeFreq string
+		Priority   string
+	}
+	var got []entry
+	for _, u := range urls {
+		got = append(got, entry{u.Loc, u.ChangeFreq, u.Priority})
+	}
+
+	// /docs/later is coming soon and /users/:id has a parameter outside the
+	// docs, neither is listed. Priorities drop by 0.1 a level.
+	want := []entry{
+		{origin + "/", "weekly", "1.0"},
+		{origin + "/docs", "weekly", "0.9"},
+		{origin + "/docs/guide", "monthly", "0.8"},
+		{origin + "/docs/intro", "monthly", "0.8"},
+		{origin + "/docs/routing", "monthly", "0.8"},
+		{origin + "/playground/examples", "monthly", "0.8"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("collect:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestSourceOf(t *testing.T) {
+	root := filepath.Join("testdata", "site")
+	site, err := routes.Load(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path   string
+		source string
+		listed bool
+	}{
+		{"/", "app/pages/home/home.go", true},
+		{"/docs", "app/pages/docs/docs.go", true},
+		{"/docs/guide", routes.RegistryFile, true},
+		{"/docs/intro", "app/pages/docs/content/guide/intro.md", true},
+		{"/docs/routing", "app/pages/docs/guide/routing.go", true},
+		{"/docs/later", "", false},
+	}
+	for _, tt := range tests {
+		source, listed, err := sourceOf(root, "example.com/site", site, tt.path)
+		if err != nil {
+			t.Errorf("%s: %v", tt.path, err)
+			continue
+		}
+		if filepath.ToSlash(source) != tt.source || listed != tt.listed {
+			t.Errorf("sourceOf(%s) = %q, %v, want %q, %v", tt.path, source, listed, tt.source, tt.listed)
+		}
+	}
+}
+
+func TestSourceOfDocsBase(t *testing.T) {
+	root := filepath.Join("testdata", "site")
+	site, err := routes.Load(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A second docs route, later in pattern order, does not build the landing page
+	site.Handlers["/docs/:section/:example"] = routes.Func{Package: "example.com/site/app/pages/home", Name: "Examples"}
+
+	for range 20 {
+		source, _, err := sourceOf(root, "example.com/site", site, "/docs")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "app/pages/docs/docs.go"; filepath.ToSlash(source) != want {
+			t.Fatalf("sourceOf(/docs) = %q, want %q", source, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	urls := []url{{Loc: origin + "/"}, {Loc: origin + "/docs"}, {Loc: origin + "/docs/intro"}}
+
+	existing, err := emit(urls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if problems := compare(existing, urls); len(problems) > 0 {
+		t.Errorf("sitemap differs from itself: %q", problems)
+	}
+
+	stale, err := emit([]url{{Loc: origin + "/"}, {Loc: origin + "/docs/later"}, {Loc: origin + "/blog"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"missing route " + origin + "/docs",
+		"missing route " + origin + "/docs/intro",
+		"lists " + origin + "/blog, which is not a route or still coming soon",
+		"lists " + origin + "/docs/later, which is not a route or still coming soon",
+	}
+	if got := compare(stale, urls); !reflect.DeepEqual(got, want) {
+		t.Errorf("compare:\n got %q\nwant %q", got, want)
+	}
+
+	if problems := compare([]byte("<urlset"), urls); len(problems) != 1 {
+		t.Errorf("compare of malformed XML = %q, want one error", problems)
+	}
+}
+
+// TestCommittedSitemap fails when a route of the app is missing from
+// web/sitemap.xml, or it lists one that is gone
+func TestCommittedSitemap(t *testing.T) {
+	root := filepath.Join("..", "..")
+	urls, err := collect(root, origin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing, err := os.ReadFile(filepath.Join(root, outputFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range compare(existing, urls) {
+		t.Errorf("%s: %s, run go run ./cmd/sitemap", outputFile, problem)
+	}
+}
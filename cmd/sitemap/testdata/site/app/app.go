@@ -0,0 +1,16 @@
+package app
+
+import (
+	"example.com/site/app/pages/docs"
+	"example.com/site/app/pages/home"
+
+	"github.com/gofred-io/gofred/foundation/router"
+)
+
+func routes() {
+	router.Route("/", home.New)
+	router.Route("/docs/:section", docs.New)
+	router.Route("/playground/examples", home.Examples)
+	router.Route("/users/:id", home.New)
+	router.NotFound(home.New)
+}
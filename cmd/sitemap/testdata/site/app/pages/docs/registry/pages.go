@@ -0,0 +1,18 @@
+package registry
+
+import (
+	"example.com/site/app/pages/docs/content"
+	"example.com/site/app/pages/docs/guide"
+)
+
+var (
+	categories = []Category{
+		{ID: "guide", Title: "Guide", Order: 1},
+	}
+
+	pages = []Page{
+		{Slug: "intro", Title: "Intro", Category: "guide", Order: 1, Content: content.Page("guide/intro.md")},
+		{Slug: "routing", Title: "Routing", Category: "guide", Order: 2, Content: guide.RoutingContent},
+		{Slug: "later", Title: "Later", Category: "guide", Order: 3},
+	}
+)
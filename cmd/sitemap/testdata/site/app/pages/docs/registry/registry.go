@@ -0,0 +1,3 @@
+package registry
+
+const BasePath = "/docs"
@@ -0,0 +1,5 @@
+package home
+
+func New() {}
+
+func Examples() {}
@@ -0,0 +1,95 @@
+// Themecheck validates the theme files the app embeds.
+//
+// Every .json, .yaml and .yml file of app/theme/themes is parsed the way
+// theme.Load parses it at run time, or the files given as arguments instead.
+// Unknown keys, malformed colours and values of the wrong type are printed as
+// file:line and the exit status is 1. Theme names must be unique and match
+// the file name, so the picker and the stored preference agree.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/theme/themefile"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const (
+	themesDir = "app/theme/themes"
+)
+
+func main() {
+	root := flag.String("root", "", "module root (default: nearest directory with go.mod)")
+	flag.Parse()
+
+	files := flag.Args()
+	if len(files) == 0 {
+		if *root == "" {
+			dir, err := routes.FindRoot(".")
+			if err != nil {
+				fatal(err)
+			}
+			*root = dir
+		}
+		entries, err := os.ReadDir(filepath.Join(*root, themesDir))
+		if err != nil {
+			fatal(err)
+		}
+		for _, entry := range entries {
+			files = append(files, filepath.Join(*root, themesDir, entry.Name()))
+		}
+	}
+
+	failed := false
+	names := make(map[string]string)
+	for _, path := range files {
+		for _, problem := range check(path, names) {
+			fmt.Fprintln(os.Stderr, problem)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// check parses the theme file at path and returns its problems. names maps
+// the theme names seen so far to their files.
+func check(path string, names map[string]string) []error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []error{err}
+	}
+	file, err := themefile.Parse(path, data)
+	if err != nil {
+		var list themefile.Errors
+		if errors.As(err, &list) {
+			problems := make([]error, len(list))
+			for i, problem := range list {
+				problems[i] = problem
+			}
+			return problems
+		}
+		return []error{err}
+	}
+
+	var problems []error
+	if stem := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)); file.Name != stem {
+		problems = append(problems, fmt.Errorf("%s: name %q must match the file name %q", path, file.Name, stem))
+	}
+	if other, ok := names[file.Name]; ok {
+		problems = append(problems, fmt.Errorf("%s: name %q is taken by %s", path, file.Name, other))
+	}
+	names[file.Name] = path
+	return problems
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "themecheck:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,243 @@
+//go:build js && wasm
+
+// Package domtest runs the app in the page of a test and reads what it
+// renders, for tests built with GOOS=js GOARCH=wasm and for cmd/prerender,
+// which keeps a snapshot of every route.
+//
+// The tests run under Node through node/run.js, which loads web/index.html
+// into jsdom and installs its window as the global object, so gofred renders
+// into it the way it does in a browser. jsdom has no layout: Visible only
+// follows display, visibility and the hidden attribute, and media queries
+// never match.
+package domtest
+
+import (
+	"fmt"
+	"strings"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/gofred-io/gofred/application"
+	"github.com/gofred-io/gofred/hooks"
+)
+
+const (
+	// poll is how often the page is read while waiting for it
+	poll = 20 * time.Millisecond
+
+	// timeout is how long the page has to settle
+	timeout = 5 * time.Second
+)
+
+// Link is an anchor of the page
+type Link struct {
+	Text   string
+	Href   string
+	Label  string
+	NewTab bool
+}
+
+// Start runs app in the page of the test binary, call it from TestMain
+func Start(app application.BaseWidget) {
+	go application.Run(app)
+	settle()
+}
+
+// Visit navigates the app to path, which may end in a #fragment, and waits
+// until the page is rendered
+func Visit(t testing.TB, path string) {
+	t.Helper()
+	if err := Open(path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Open is Visit outside of tests
+func Open(path string) error {
+	hooks.UseNavigate().Navigate(path)
+	if !settle() {
+		return fmt.Errorf("%s still changing after %s", path, timeout)
+	}
+	location := js.Global().Get("location")
+	if got := location.Get("pathname").String() + location.Get("hash").String(); got != path {
+		return fmt.Errorf("navigated to %s, the page is at %s", path, got)
+	}
+	return nil
+}
+
+// settle waits until the body stops changing, letting the event loop of the
+// page run in between, and reports whether it did in time
+func settle() bool {
+	body := document().Get("body")
+	last := ""
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); {
+		time.Sleep(poll)
+		html := body.Get("innerHTML").String()
+		if html == last && html != "" {
+			return true
+		}
+		last = html
+	}
+	return false
+}
+
+// Text returns the visible text of the page with runs of white space
+// collapsed to one space
+func Text() string {
+	var words []string
+	walk(document().Get("body"), func(node js.Value) bool {
+		switch node.Get("nodeType").Int() {
+		case 3:
+			words = append(words, strings.Fields(node.Get("data").String())...)
+			return false
+		case 1:
+			switch node.Get("tagName").String() {
+			case "SCRIPT", "STYLE", "NOSCRIPT":
+				return false
+			}
+			return Visible(node)
+		}
+		return false
+	})
+	return strings.Join(words, " ")
+}
+
+// Links returns the visible links of the page in document order
+func Links() []Link {
+	var links []Link
+	for _, a := range All("a[href]") {
+		if !Visible(a) {
+			continue
+		}
+		links = append(links, Link{
+			Text:   strings.Join(strings.Fields(a.Get("textContent").String()), " "),
+			Href:   a.Call("getAttribute", "href").String(),
+			Label:  attribute(a, "aria-label"),
+			NewTab: attribute(a, "target") == "_blank",
+		})
+	}
+	return links
+}
+
+// Labelled returns the elements named label for screen readers
+func Labelled(label string) []js.Value {
+	var found []js.Value
+	for _, element := range All("[aria-label]") {
+		if attribute(element, "aria-label") == label {
+			found = append(found, element)
+		}
+	}
+	return found
+}
+
+// All returns the elements matching the CSS selector
+func All(selector string) []js.Value {
+	list := document().Call("querySelectorAll", selector)
+	elements := make([]js.Value, list.Length())
+	for i := range elements {
+		elements[i] = list.Index(i)
+	}
+	return elements
+}
+
+// Visible reports whether element and all its ancestors are shown
+func Visible(element js.Value) bool {
+	window := js.Global()
+	for ; element.Truthy() && element.Get("nodeType").Int() == 1; element = element.Get("parentElement") {
+		if element.Call("hasAttribute", "hidden").Bool() {
+			return false
+		}
+		style := window.Call("getComputedStyle", element)
+		if style.Get("display").String() == "none" || style.Get("visibility").String() == "hidden" {
+			return false
+		}
+	}
+	return true
+}
+
+// Click clicks element and waits for the page to settle
+func Click(t testing.TB, element js.Value) {
+	t.Helper()
+	element.Call("click")
+	if !settle() {
+		t.Fatalf("page still changing %s after the click", timeout)
+	}
+}
+
+// Press sends a keydown of key to the focused element, or the document, and
+// waits for the page to settle. Modifiers are "ctrl", "meta", "alt" and "shift".
+func Press(t testing.TB, key string, modifiers ...string) {
+	t.Helper()
+	init := map[string]any{"key": key, "bubbles": true, "cancelable": true}
+	for _, modifier := range modifiers {
+		init[modifier+"Key"] = true
+	}
+	target := document().Get("activeElement")
+	if !target.Truthy() {
+		target = document()
+	}
+	target.Call("dispatchEvent", js.Global().Get("KeyboardEvent").New("keydown", init))
+	if !settle() {
+		t.Fatalf("page still changing %s after pressing %s", timeout, key)
+	}
+}
+
+// Snapshot is the page as rendered, the markup of #root and the styles the
+// app added to the document
+type Snapshot struct {
+	Root string `json:"root"`
+
+	// Style holds the rules of the <style> elements the app added, and the
+	// properties it set on <html> like the --color-* tokens of the theme
+	Style string `json:"style"`
+}
+
+// Capture returns the snapshot of the page shown. The stylesheets of the
+// page itself, which node/run.js marks with data-shell, are left out.
+func Capture() Snapshot {
+	var rules []string
+	if style := document().Get("documentElement").Get("style").Get("cssText").String(); style != "" {
+		rules = append(rules, ":root { "+style+" }")
+	}
+	for _, element := range All("style") {
+		if element.Call("hasAttribute", "data-shell").Bool() || element.Call("closest", "#root").Truthy() {
+			continue
+		}
+		// Rules inserted through the CSSOM are not in the text of the element
+		sheet := element.Get("sheet")
+		if !sheet.Truthy() {
+			rules = append(rules, element.Get("textContent").String())
+			continue
+		}
+		list := sheet.Get("cssRules")
+		for i := 0; i < list.Length(); i++ {
+			rules = append(rules, list.Index(i).Get("cssText").String())
+		}
+	}
+	return Snapshot{
+		Root:  document().Call("getElementById", "root").Get("innerHTML").String(),
+		Style: strings.Join(rules, "\n"),
+	}
+}
+
+func walk(node js.Value, visit func(js.Value) bool) {
+	if !visit(node) {
+		return
+	}
+	for child := node.Get("firstChild"); child.Truthy(); child = child.Get("nextSibling") {
+		walk(child, visit)
+	}
+}
+
+func attribute(element js.Value, name string) string {
+	value := element.Call("getAttribute", name)
+	if value.IsNull() {
+		return ""
+	}
+	return value.String()
+}
+
+func document() js.Value {
+	return js.Global().Get("document")
+}
@@ -0,0 +1,97 @@
+// Runs a Go test binary built for GOOS=js GOARCH=wasm with web/index.html
+// loaded into jsdom as the page, the way go_js_wasm_exec runs it with no page
+// at all. Used through go test -exec, see the test-pages target of the
+// Makefile, and by cmd/prerender to run cmd/prerender/snapshot.
+
+"use strict";
+
+const fs = require("fs");
+const path = require("path");
+const { execFileSync } = require("child_process");
+const { JSDOM, VirtualConsole } = require("jsdom");
+
+if (process.argv.length < 3) {
+  console.error("usage: node run.js [wasm binary] [arguments]");
+  process.exit(1);
+}
+
+const web = path.join(__dirname, "..", "..", "..", "web");
+
+// The page without its scripts, env.js and index.js belong to the browser
+// build and the test binary takes the place of main.wasm
+const virtualConsole = new VirtualConsole();
+virtualConsole.on("jsdomError", (error) => {
+  // jsdom does not know every CSS feature of index.css, and has no layout
+  if (!/Could not parse CSS stylesheet|Not implemented/.test(error.message)) {
+    console.error(error);
+  }
+});
+const dom = new JSDOM(fs.readFileSync(path.join(web, "index.html")), {
+  url: "http://localhost:8080/",
+  pretendToBeVisual: true,
+  virtualConsole,
+});
+const { window } = dom;
+const { document } = window;
+
+// Link preloads are never applied, inline the stylesheets so classes that
+// hide things, like .gf-hidden, do
+for (const name of ["gofred.css", "index.css"]) {
+  const style = document.createElement("style");
+  style.dataset.shell = name;
+  style.textContent = fs.readFileSync(path.join(web, name), "utf8");
+  document.head.append(style);
+}
+document.documentElement.dataset.theme = "light";
+document.documentElement.dataset.scheme = "light";
+
+window.env = {};
+window.matchMedia = (media) => ({
+  media,
+  matches: false,
+  addEventListener() {},
+  removeEventListener() {},
+  addListener() {},
+  removeListener() {},
+});
+window.scrollTo = () => {};
+window.Element.prototype.scrollIntoView = () => {};
+
+// syscall/js reads everything from globalThis, so it becomes the window
+for (const key of Object.getOwnPropertyNames(window)) {
+  if (key in globalThis) {
+    continue;
+  }
+  const value = window[key];
+  globalThis[key] = typeof value === "function" && /^[a-z]/.test(key) ? value.bind(window) : value;
+}
+
+// What wasm_exec_node.js of the Go distribution sets up
+globalThis.require = require;
+globalThis.fs = fs;
+globalThis.path = path;
+globalThis.TextEncoder = require("util").TextEncoder;
+globalThis.TextDecoder = require("util").TextDecoder;
+globalThis.performance ??= require("perf_hooks").performance;
+globalThis.crypto ??= require("crypto");
+
+const goroot = execFileSync("go", ["env", "GOROOT"]).toString().trim();
+require(path.join(goroot, "lib", "wasm", "wasm_exec.js"));
+
+const go = new Go();
+go.argv = process.argv.slice(2);
+go.env = Object.assign({ TMPDIR: require("os").tmpdir() }, process.env);
+go.exit = process.exit;
+WebAssembly.instantiate(fs.readFileSync(process.argv[2]), go.importObject).then((result) => {
+  process.on("exit", (code) => {
+    if (code === 0 && !go.exited) {
+      // deadlock, make Go print error and stack traces
+      go._pendingEvent = { id: 0 };
+      go._resume();
+    }
+  });
+  return go.run(result.instance);
+}).catch((err) => {
+  console.error(err);
+  process.exit(1);
+});
@@ -0,0 +1,361 @@
+// Package pagetext reads the headings, prose and code samples of a page from
+// its source, for host tools like the search index.
+//
+// Markdown pages are read through the same layout the docs generator uses.
+// Go pages are read by walking a function and the package functions it
+// calls, taking the string literals passed to section helpers as headings,
+// to code blocks as code and to everything else as prose.
+package pagetext
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gofred-io/gofred-website/app/markdown"
+	"github.com/gofred-io/gofred-website/app/markdown/layout"
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+const (
+	contentDir = "app/pages/docs/content"
+)
+
+// codePackages are the import names of the code block widgets
+var codePackages = map[string]bool{
+	"codeblock":  true,
+	"code_block": true,
+}
+
+// Section is a heading and the prose and code samples that follow it. The
+// first section of a page has no title and holds its introduction.
+type Section struct {
+	Title string
+
+	// Level is 2 for sections and 3 for subsections, 0 for the introduction
+	Level int
+
+	Text []string
+	Code []string
+}
+
+// Markdown reads the Markdown page at path
+func Markdown(path string) ([]Section, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	page := layout.Build(markdown.Parse(source))
+
+	docs := []Section{{}}
+	current := &docs[0]
+	addText := func(value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			current.Text = append(current.Text, value)
+		}
+	}
+
+	addText(markdown.Text(page.Description))
+	for _, element := range page.Elements {
+		switch element.Kind {
+		case layout.Section, layout.Subsection:
+			level := 2
+			if element.Kind == layout.Subsection {
+				level = 3
+			}
+			docs = append(docs, Section{Title: element.Title, Level: level})
+			current = &docs[len(docs)-1]
+			addText(markdown.Text(element.Description))
+		case layout.Paragraph:
+			addText(markdown.Text(element.Inlines))
+		case layout.CheckList, layout.OrderedList:
+			for _, item := range element.Items {
+				addText(markdown.Text(item.Inlines))
+			}
+		case layout.TermList, layout.NextSteps:
+			for _, item := range element.Items {
+				addText(item.Title)
+				addText(item.Description)
+			}
+		case layout.Code:
+			current.Code = append(current.Code, element.Code)
+		case layout.CodeGroup:
+			for _, tab := range element.Tabs {
+				current.Code = append(current.Code, tab.Code)
+			}
+		}
+	}
+
+	return dropEmpty(docs), nil
+}
+
+// Go reads the page built by function in the package at dir, following calls
+// to other functions of the package in source order
+func Go(dir, function string) ([]Section, error) {
+	funcs, err := parseFuncs(token.NewFileSet(), dir)
+	if err != nil {
+		return nil, err
+	}
+	if funcs[function] == nil {
+		return nil, fmt.Errorf("function %s not found in %s", function, dir)
+	}
+
+	w := &walker{funcs: funcs, visited: make(map[string]bool), docs: []Section{{}}}
+	w.walk(function)
+	return dropEmpty(w.docs), nil
+}
+
+// parseFuncs returns the package level functions of the package in dir
+func parseFuncs(fset *token.FileSet, dir string) (map[string]*ast.FuncDecl, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+	for _, match := range matches {
+		if strings.HasSuffix(match, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, match, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				funcs[fn.Name.Name] = fn
+			}
+		}
+	}
+	return funcs, nil
+}
+
+type walker struct {
+	funcs   map[string]*ast.FuncDecl
+	visited map[string]bool
+	docs    []Section
+}
+
+func (w *walker) current() *Section {
+	return &w.docs[len(w.docs)-1]
+}
+
+func (w *walker) walk(name string) {
+	w.visited[name] = true
+
+	ast.Inspect(w.funcs[name].Body, func(node ast.Node) bool {
+		if list, ok := node.(*ast.CompositeLit); ok {
+			if code, ok := blockCode(list); ok {
+				if code != "" {
+					w.current().Code = append(w.current().Code, strings.TrimSpace(code))
+				}
+				return true
+			}
+			// Tables of list items, like the best practices of a page
+			w.addText(stringElements(list))
+			return true
+		}
+
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		literals := stringArgs(call)
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			if level := headingLevel(fun.Name); level > 0 && len(literals) > 0 {
+				w.docs = append(w.docs, Section{Title: literals[0], Level: level})
+				literals = literals[1:]
+			}
+			w.addText(literals)
+			if w.funcs[fun.Name] != nil && !w.visited[fun.Name] {
+				w.walk(fun.Name)
+			}
+		case *ast.SelectorExpr:
+			pkg, ok := fun.X.(*ast.Ident)
+			if !ok || fun.Sel.Name != "New" {
+				break
+			}
+			switch {
+			case codePackages[pkg.Name]:
+				for _, code := range literals {
+					w.current().Code = append(w.current().Code, strings.TrimSpace(code))
+				}
+			case pkg.Name == "text":
+				w.addText(literals)
+			}
+		}
+		return true
+	})
+}
+
+func (w *walker) addText(literals []string) {
+	for _, literal := range literals {
+		if isProse(literal) {
+			w.current().Text = append(w.current().Text, strings.TrimSpace(literal))
+		}
+	}
+}
+
+// headingLevel matches the section helpers the hand-written pages define,
+// like contentSection, stateSubsection or eventContentSection, and returns
+// the level of the heading they render or 0 for other functions
+func headingLevel(name string) int {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, "subsection"):
+		return 3
+	case strings.HasSuffix(name, "section"):
+		return 2
+	}
+	return 0
+}
+
+// isProse filters out colors, routes and other literals that are not page text
+func isProse(value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "#") || strings.HasPrefix(value, "/") || strings.Contains(value, "://") {
+		return false
+	}
+	return strings.IndexFunc(value, unicode.IsLetter) >= 0
+}
+
+func stringArgs(call *ast.CallExpr) []string {
+	var literals []string
+	for _, arg := range call.Args {
+		lit, ok := arg.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if value, err := strconv.Unquote(lit.Value); err == nil {
+			literals = append(literals, value)
+		}
+	}
+	return literals
+}
+
+// blockCode returns the Code of a codeblock.Block literal. ok is set for
+// all the literals of the code block package, like tabs, whose other
+// strings are not prose.
+func blockCode(list *ast.CompositeLit) (code string, ok bool) {
+	sel, isSel := list.Type.(*ast.SelectorExpr)
+	if !isSel {
+		return "", false
+	}
+	if pkg, isIdent := sel.X.(*ast.Ident); !isIdent || !codePackages[pkg.Name] {
+		return "", false
+	}
+	for _, elt := range list.Elts {
+		kv, isKV := elt.(*ast.KeyValueExpr)
+		if !isKV {
+			continue
+		}
+		if key, isIdent := kv.Key.(*ast.Ident); isIdent && key.Name == "Code" {
+			if lit, isLit := kv.Value.(*ast.BasicLit); isLit && lit.Kind == token.STRING {
+				code, _ = strconv.Unquote(lit.Value)
+			}
+		}
+	}
+	return code, true
+}
+
+func stringElements(list *ast.CompositeLit) []string {
+	var literals []string
+	for _, elt := range list.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Value
+		}
+		lit, ok := elt.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if value, err := strconv.Unquote(lit.Value); err == nil {
+			literals = append(literals, value)
+		}
+	}
+	return literals
+}
+
+func dropEmpty(docs []Section) []Section {
+	var result []Section
+	for _, doc := range docs {
+		if doc.Title != "" || len(doc.Text) > 0 || len(doc.Code) > 0 {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
+
+// Page reads a docs page of the registry, Markdown or Go
+func Page(root, module string, site *routes.Site, page routes.Page) ([]Section, error) {
+	if path, ok := markdownPath(page.Content); ok {
+		return Markdown(filepath.Join(root, contentDir, path))
+	}
+	dir, function, err := goFunction(site, module, page.Content)
+	if err != nil {
+		return nil, err
+	}
+	return Go(filepath.Join(root, dir), function)
+}
+
+// Source returns the file a docs page is written in, relative to root. For
+// Go pages that is the file declaring the content function.
+func Source(root, module string, site *routes.Site, page routes.Page) (string, error) {
+	if path, ok := markdownPath(page.Content); ok {
+		return filepath.Join(contentDir, path), nil
+	}
+	dir, function, err := goFunction(site, module, page.Content)
+	if err != nil {
+		return "", err
+	}
+	return DeclFile(root, dir, function)
+}
+
+// DeclFile returns the file of the package in dir that declares function,
+// relative to root
+func DeclFile(root, dir, function string) (string, error) {
+	fset := token.NewFileSet()
+	funcs, err := parseFuncs(fset, filepath.Join(root, dir))
+	if err != nil {
+		return "", err
+	}
+	fn, ok := funcs[function]
+	if !ok {
+		return "", fmt.Errorf("function %s not found in %s", function, dir)
+	}
+	return filepath.Rel(root, fset.Position(fn.Pos()).Filename)
+}
+
+// markdownPath returns the file of a content.Page("...") expression
+func markdownPath(expr string) (string, bool) {
+	arg, ok := strings.CutPrefix(expr, "content.Page(")
+	if !ok {
+		return "", false
+	}
+	path, err := strconv.Unquote(strings.TrimSuffix(arg, ")"))
+	return path, err == nil
+}
+
+// goFunction resolves a pkg.Function expression to the package directory and function name
+func goFunction(site *routes.Site, module, expr string) (string, string, error) {
+	pkg, function, ok := strings.Cut(expr, ".")
+	if !ok {
+		return "", "", fmt.Errorf("unsupported content expression %q", expr)
+	}
+	importPath, ok := site.Imports[pkg]
+	if !ok {
+		return "", "", fmt.Errorf("unknown package %q", pkg)
+	}
+	dir, ok := routes.Func{Package: importPath, Name: function}.Dir(module)
+	if !ok {
+		return "", "", fmt.Errorf("package %q is outside the module", importPath)
+	}
+	return dir, function, nil
+}
@@ -0,0 +1,142 @@
+package pagetext
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gofred-io/gofred-website/internal/routes"
+)
+
+func TestMarkdown(t *testing.T) {
+	got, err := Markdown(filepath.Join("testdata", "page.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Section{
+		{Text: []string{"Map URLs to pages with the router."}},
+		{
+			Title: "Routes",
+			Level: 2,
+			Text:  []string{"Each route pairs a pattern with a page.", "Static routes like /about", "Parameters like /docs/:section"},
+			Code:  []string{`router.Route("/", home.New)`},
+		},
+		{
+			Title: "Not found",
+			Level: 3,
+			Text:  []string{"Unknown paths render the fallback."},
+			Code:  []string{"open http://localhost:8080", "xdg-open http://localhost:8080"},
+		},
+		{
+			Title: "Next Steps",
+			Level: 2,
+			Text:  []string{"Widgets", "Build pages from widgets"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Markdown:\n got %#v\nwant %#v", got, want)
+	}
+}
+
+func TestGo(t *testing.T) {
+	got, err := Go(filepath.Join("testdata", "widgets"), "WidgetsContent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Calls are followed where they are made, so "Read more" comes after
+	// what layoutExamples adds, and layoutExamples calling itself is walked
+	// once. Colours, routes, URLs and numbers are not prose.
+	want := []Section{
+		{Text: []string{"Widgets are the building blocks of every page."}},
+		{
+			Title: "Layouts",
+			Level: 2,
+			Text:  []string{"Rows and columns arrange their children."},
+		},
+		{
+			Title: "Rows",
+			Level: 3,
+			Text:  []string{"Keep widgets small", "Read more"},
+			Code:  []string{"row.New(children)", "column.New(children)"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Go:\n got %#v\nwant %#v", got, want)
+	}
+
+	if _, err := Go(filepath.Join("testdata", "widgets"), "MissingContent"); err == nil {
+		t.Error("Go with an unknown function succeeded")
+	}
+}
+
+func TestPage(t *testing.T) {
+	site := &routes.Site{Imports: map[string]string{
+		"widgets": "example.com/site/internal/pagetext/testdata/widgets",
+		"other":   "example.org/other",
+	}}
+	const module = "example.com/site"
+	root := filepath.Join("..", "..")
+
+	sections, err := Page(root, module, site, routes.Page{Content: `content.Page("../../../../internal/pagetext/testdata/page.md")`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 4 || sections[1].Title != "Routes" {
+		t.Errorf("Markdown page read as %#v", sections)
+	}
+
+	sections, err = Page(root, module, site, routes.Page{Content: "widgets.WidgetsContent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 3 || sections[2].Title != "Rows" {
+		t.Errorf("Go page read as %#v", sections)
+	}
+
+	source, err := Source(root, module, site, routes.Page{Content: "widgets.WidgetsContent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join("internal", "pagetext", "testdata", "widgets", "widgets.go"); source != want {
+		t.Errorf("Source = %q, want %q", source, want)
+	}
+
+	for _, content := range []string{"Content", "missing.Content", "other.Content"} {
+		if _, err := Page(root, module, site, routes.Page{Content: content}); err == nil {
+			t.Errorf("Page with content %q succeeded", content)
+		}
+	}
+}
+
+func TestHeadingLevel(t *testing.T) {
+	for name, want := range map[string]int{
+		"contentSection":      2,
+		"stateSubsection":     3,
+		"eventContentSection": 2,
+		"Section":             2,
+		"codeExample":         0,
+	} {
+		if got := headingLevel(name); got != want {
+			t.Errorf("headingLevel(%q) = %d, want %d", name, got, want)
+		}
+	}
+}
+
+func TestIsProse(t *testing.T) {
+	for value, want := range map[string]bool{
+		"Widgets are the building blocks": true,
+		"  padded  ":                      true,
+		"":                                false,
+		"#6B7280":                         false,
+		"/docs/widgets":                   false,
+		"https://gofred.io":               false,
+		"42":                              false,
+		"...":                             false,
+	} {
+		if got := isProse(value); got != want {
+			t.Errorf("isProse(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
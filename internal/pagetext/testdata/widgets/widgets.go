@@ -0,0 +1,36 @@
+package widgets
+
+func WidgetsContent() application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			text.New("Widgets are the building blocks of every page.", text.FontColor("#6B7280")),
+			widgetsSection("Layouts", "Rows and columns arrange their children."),
+			layoutExamples(),
+			link.New(text.New("Read more"), link.Href("/docs/widgets")),
+		},
+	)
+}
+
+func layoutExamples() application.BaseWidget {
+	return column.New(
+		[]application.BaseWidget{
+			widgetsSubsection("Rows"),
+			codeblock.New(`row.New(children)`),
+			codeblock.Build(codeblock.Block{Language: "go", Code: "column.New(children)"}),
+			tips([]string{"Keep widgets small", "https://gofred.io", "42"}),
+			layoutExamples(),
+		},
+	)
+}
+
+func widgetsSection(title, description string) application.BaseWidget {
+	return text.New(title)
+}
+
+func widgetsSubsection(title string) application.BaseWidget {
+	return text.New(title)
+}
+
+func tips(items []string) application.BaseWidget {
+	return column.New(nil)
+}
@@ -0,0 +1,91 @@
+package playground
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MaxSource is the largest program accepted, in bytes
+const MaxSource = 64 << 10
+
+// Handler serves the compile endpoint of the /playground page. A POST with
+// the source of a program as its body gets the WebAssembly module back, or
+// 422 Unprocessable Entity with the compiler output as text.
+//
+// Requests from pages of other origins than AllowOrigin get 403 Forbidden,
+// so other sites can't make the server compile for them. Requests without
+// an Origin header, which browsers send with every POST, are served.
+type Handler struct {
+	Compiler *Compiler
+
+	// AllowOrigin is the origin of the pages that may call the handler, like
+	// "http://localhost:8080", sent as Access-Control-Allow-Origin. Empty
+	// allows the origin of the handler only and "*" any origin.
+	AllowOrigin string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" && !h.allowed(origin, r.Host) {
+		http.Error(w, "origin "+origin+" may not use the playground", http.StatusForbidden)
+		return
+	}
+	if h.AllowOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", h.AllowOrigin)
+		w.Header().Set("Access-Control-Allow-Methods", "POST")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	}
+
+	switch r.Method {
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodPost:
+	default:
+		w.Header().Set("Allow", "POST, OPTIONS")
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	source, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxSource))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "programs are limited to 64 KiB", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	wasm, err := h.Compiler.Compile(r.Context(), string(source))
+	if err != nil {
+		var compileErr *CompileError
+		if errors.As(err, &compileErr) {
+			http.Error(w, compileErr.Output, http.StatusUnprocessableEntity)
+			return
+		}
+		log.Printf("playground: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	w.Write(wasm)
+}
+
+// allowed reports whether pages of origin may call the handler served on
+// host. Same origin compares hosts only, the scheme is lost behind proxies
+// ending TLS.
+func (h *Handler) allowed(origin, host string) bool {
+	switch h.AllowOrigin {
+	case "*":
+		return true
+	case "":
+		u, err := url.Parse(origin)
+		return err == nil && u.Host != "" && strings.EqualFold(u.Host, host)
+	}
+	return strings.EqualFold(strings.TrimSuffix(origin, "/"), strings.TrimSuffix(h.AllowOrigin, "/"))
+}
@@ -0,0 +1,88 @@
+package playground
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		want   int
+	}{
+		{"GET", http.MethodGet, "", http.StatusMethodNotAllowed},
+		{"too large", http.MethodPost, strings.Repeat("a", MaxSource+1), http.StatusRequestEntityTooLarge},
+		{"not package main", http.MethodPost, "package store\n", http.StatusUnprocessableEntity},
+		{"preflight", http.MethodOptions, "", http.StatusNoContent},
+	}
+	// None of the requests gets as far as building
+	h := &Handler{Compiler: &Compiler{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(tt.method, "/compile", strings.NewReader(tt.body)))
+			if w.Code != tt.want {
+				t.Fatalf("status %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			switch w.Code {
+			case http.StatusMethodNotAllowed:
+				if got := w.Header().Get("Allow"); got != "POST, OPTIONS" {
+					t.Errorf("Allow = %q, want %q", got, "POST, OPTIONS")
+				}
+			case http.StatusUnprocessableEntity:
+				if want := "main.go:1:9: the playground runs package main, not package store\n"; w.Body.String() != want {
+					t.Errorf("body = %q, want %q", w.Body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlerOrigin(t *testing.T) {
+	tests := []struct {
+		allow  string
+		origin string
+		want   int
+	}{
+		{"", "", http.StatusNoContent},
+		{"", "http://example.com", http.StatusNoContent},
+		{"", "http://EXAMPLE.com", http.StatusNoContent},
+		{"", "http://localhost:8080", http.StatusForbidden},
+		{"", "null", http.StatusForbidden},
+		{"http://localhost:8080", "http://localhost:8080", http.StatusNoContent},
+		{"http://localhost:8080/", "http://localhost:8080", http.StatusNoContent},
+		{"http://localhost:8080", "http://localhost:8081", http.StatusForbidden},
+		{"http://localhost:8080", "http://example.com", http.StatusForbidden},
+		{"*", "http://localhost:8081", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		h := &Handler{Compiler: &Compiler{}, AllowOrigin: tt.allow}
+		r := httptest.NewRequest(http.MethodOptions, "http://example.com/compile", nil)
+		if tt.origin != "" {
+			r.Header.Set("Origin", tt.origin)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.want {
+			t.Errorf("allow %q, origin %q: status %d, want %d", tt.allow, tt.origin, w.Code, tt.want)
+			continue
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); w.Code == http.StatusNoContent && got != tt.allow {
+			t.Errorf("allow %q: Access-Control-Allow-Origin = %q", tt.allow, got)
+		}
+	}
+
+	// Other origins are turned away before the program is read
+	h := &Handler{Compiler: &Compiler{}}
+	r := httptest.NewRequest(http.MethodPost, "http://example.com/compile", strings.NewReader("package store\n"))
+	r.Header.Set("Origin", "http://localhost:8080")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("POST from another origin: status %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,319 @@
+// Package playground compiles the programs of the site's /playground page to
+// WebAssembly.
+//
+// Programs are built in a module of their own that requires the same gofred
+// version as the site, so the docs samples compile against the version they
+// are written for. Nothing is downloaded while compiling: programs can import
+// the standard library and the modules the site's go.mod requires.
+//
+// Fragments of the docs, declarations without a package clause, are run too
+// when they declare a widget function. They get the package clause and the
+// imports of the gofred and common standard packages they name.
+//
+// The compiler is served over HTTP by cmd/devserver and, for sites served
+// some other way, by cmd/playground.
+package playground
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// module is the path of the programs' module
+	module = "playground"
+
+	sourceFile = "main.go"
+	outputFile = "main.wasm"
+
+	// applicationPackage declares BaseWidget and Run
+	applicationPackage = "github.com/gofred-io/gofred/application"
+
+	// gofredPackages are the packages fragments may use without importing
+	// them, with fragmentStd
+	gofredPackages = "github.com/gofred-io/gofred/..."
+)
+
+// fragmentStd are the standard packages fragments may use without importing
+// them, the ones cmd/samplecheck imports for the docs samples
+var fragmentStd = []string{"errors", "fmt", "math", "math/rand", "sort", "strconv", "strings", "time"}
+
+// CompileError is returned for programs that don't compile
+type CompileError struct {
+	// Output is the compiler output, positions are in main.go
+	Output string
+}
+
+func (e *CompileError) Error() string {
+	return "program does not compile:\n" + e.Output
+}
+
+// Compiler builds programs for js/wasm, one at a time
+type Compiler struct {
+	// Timeout stops builds running longer, like the first one filling the
+	// build cache on a slow machine
+	Timeout time.Duration
+
+	dir string
+	mu  sync.Mutex
+
+	// packages maps the names of the packages fragments may use, like
+	// "text", to their import paths
+	packages map[string]string
+}
+
+var modulePattern = regexp.MustCompile(`(?m)^module\s+\S+`)
+
+// New returns a compiler for programs using the modules the go.mod in root
+// requires. Close removes its files.
+func New(root string) (*Compiler, error) {
+	mod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	if !modulePattern.Match(mod) {
+		return nil, fmt.Errorf("playground: no module line in %s", filepath.Join(root, "go.mod"))
+	}
+
+	dir, err := os.MkdirTemp("", "gofred-playground-")
+	if err != nil {
+		return nil, err
+	}
+	mod = modulePattern.ReplaceAll(mod, []byte("module "+module))
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), mod, 0o644); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "go.sum"), sum, 0o644)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	c := &Compiler{Timeout: time.Minute, dir: dir}
+	c.packages = c.listPackages()
+	return c, nil
+}
+
+// listPackages returns the names and import paths of the packages fragments
+// may use. Without the gofred module at hand it lists the standard ones
+// only, and fragments using gofred fail to compile with undefined names.
+func (c *Compiler) listPackages() map[string]string {
+	args := append([]string{"list", "-e", "-f", "{{if not .Error}}{{.Name}} {{.ImportPath}}{{end}}", gofredPackages}, fragmentStd...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = c.dir
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm", "GOFLAGS=-mod=readonly", "GOTOOLCHAIN=local")
+	out, _ := cmd.Output()
+
+	packages := make(map[string]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		name, path, ok := strings.Cut(line, " ")
+		if !ok || strings.Contains(path, "/internal/") {
+			continue
+		}
+		// Names used by several packages go to the shortest path, and
+		// packages like icon_data are known as icondata too
+		for _, n := range []string{name, strings.ReplaceAll(name, "_", "")} {
+			if other, ok := packages[n]; !ok || len(path) < len(other) {
+				packages[n] = path
+			}
+		}
+	}
+	return packages
+}
+
+// Close removes the compiler's module
+func (c *Compiler) Close() error {
+	return os.RemoveAll(c.dir)
+}
+
+// Compile builds source, a package main file, and returns the WebAssembly
+// module. Programs without a main function run the first function returning
+// an application.BaseWidget without taking arguments, like the counterWidget
+// of the docs.
+func (c *Compiler) Compile(ctx context.Context, source string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	source, err := program(source, c.packages)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(c.dir, sourceFile), []byte(source), 0o644); err != nil {
+		return nil, err
+	}
+	output := filepath.Join(c.dir, outputFile)
+	os.Remove(output)
+
+	cmd := exec.CommandContext(ctx, "go", "build", "-trimpath", "-o", outputFile, ".")
+	cmd.Dir = c.dir
+	cmd.Env = append(os.Environ(),
+		"GOOS=js", "GOARCH=wasm", "CGO_ENABLED=0",
+		"GOFLAGS=-mod=readonly", "GOTOOLCHAIN=local",
+	)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("build stopped: %w", ctx.Err())
+		}
+		var exit *exec.ExitError
+		if !errors.As(err, &exit) {
+			return nil, err
+		}
+		return nil, &CompileError{Output: compilerOutput(out.String())}
+	}
+	return os.ReadFile(output)
+}
+
+// compilerOutput drops the package header go build prints and the ./ in
+// front of the file name
+func compilerOutput(output string) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if strings.HasPrefix(line, "# ") {
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(line, "./"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// program returns source with a main function added when it has none and
+// declares a widget function to run. Fragments, sources without a package
+// clause, get one and the imports of the packages they name, among
+// packages. Sources that don't parse are returned as they are, for the
+// compiler to report.
+func program(source string, packages map[string]string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sourceFile, source, 0)
+	if err != nil {
+		fragment, ok := withPackage(fset, source, packages)
+		if !ok {
+			return source, nil
+		}
+		source = fragment
+		if file, err = parser.ParseFile(fset, sourceFile, source, 0); err != nil {
+			return source, nil
+		}
+	}
+	if file.Name.Name != "main" {
+		position := fset.Position(file.Name.Pos())
+		return "", &CompileError{Output: fmt.Sprintf("%s:%d:%d: the playground runs package main, not package %s",
+			sourceFile, position.Line, position.Column, file.Name.Name)}
+	}
+
+	application := ""
+	for _, spec := range file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == applicationPackage {
+			application = "application"
+			if spec.Name != nil {
+				application = spec.Name.Name
+			}
+		}
+	}
+
+	widget := ""
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			continue
+		}
+		if fn.Name.Name == "main" {
+			return source, nil
+		}
+		if widget == "" && application != "" && isWidgetFunc(fn, application) {
+			widget = fn.Name.Name
+		}
+	}
+	if widget == "" {
+		return source, nil
+	}
+	return source + fmt.Sprintf("\n\nfunc main() {\n\t%s.Run(%s())\n}\n", application, widget), nil
+}
+
+// withPackage returns a fragment of declarations as a package main file
+// importing the packages it names, or false if it isn't one. Imports are
+// named, icon_data is used as icondata. A line directive keeps the
+// positions of the fragment in the compiler output.
+func withPackage(fset *token.FileSet, source string, packages map[string]string) (string, bool) {
+	const clause = "package main\n"
+	directive := "//line " + sourceFile + ":1:1\n"
+	file, err := parser.ParseFile(fset, sourceFile, clause+directive+source, 0)
+	if err != nil || len(file.Decls) == 0 {
+		return "", false
+	}
+
+	imports := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		// Names the fragment declares, like a variable called filter, are
+		// not packages
+		if x, ok := sel.X.(*ast.Ident); ok && packages[x.Name] != "" && file.Scope.Lookup(x.Name) == nil {
+			imports[x.Name] = packages[x.Name]
+		}
+		return true
+	})
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		for name, p := range imports {
+			if p == path {
+				delete(imports, name)
+			}
+		}
+	}
+
+	specs := make([]string, 0, len(imports))
+	for name, path := range imports {
+		specs = append(specs, "\t"+name+" "+strconv.Quote(path)+"\n")
+	}
+	sort.Strings(specs)
+	if len(specs) == 0 {
+		return clause + directive + source, true
+	}
+	return clause + "\nimport (\n" + strings.Join(specs, "") + ")\n\n" + directive + source, true
+}
+
+// isWidgetFunc reports whether fn takes no arguments and returns a
+// BaseWidget of the application package imported as application
+func isWidgetFunc(fn *ast.FuncDecl, application string) bool {
+	if fn.Type.TypeParams != nil || len(fn.Type.Params.List) != 0 {
+		return false
+	}
+	results := fn.Type.Results
+	if results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return false
+	}
+	sel, ok := results.List[0].Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "BaseWidget" {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == application
+}
@@ -0,0 +1,162 @@
+package playground
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProgram(t *testing.T) {
+	packages := map[string]string{
+		"application": applicationPackage,
+		"text":        "github.com/gofred-io/gofred/foundation/text",
+		"icondata":    "github.com/gofred-io/gofred/foundation/icon/icon_data",
+		"fmt":         "fmt",
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			"program with main",
+			"package main\n\nfunc main() {}\n",
+			"package main\n\nfunc main() {}\n",
+		},
+		{
+			"widget function",
+			"package main\n\nimport \"github.com/gofred-io/gofred/application\"\n\nfunc helper(n int) application.BaseWidget { return nil }\n\nfunc counter() application.BaseWidget { return nil }\n",
+			"package main\n\nimport \"github.com/gofred-io/gofred/application\"\n\nfunc helper(n int) application.BaseWidget { return nil }\n\nfunc counter() application.BaseWidget { return nil }\n" +
+				"\n\nfunc main() {\n\tapplication.Run(counter())\n}\n",
+		},
+		{
+			"application imported under another name",
+			"package main\n\nimport app \"github.com/gofred-io/gofred/application\"\n\nfunc page() app.BaseWidget { return nil }",
+			"package main\n\nimport app \"github.com/gofred-io/gofred/application\"\n\nfunc page() app.BaseWidget { return nil }" +
+				"\n\nfunc main() {\n\tapp.Run(page())\n}\n",
+		},
+		{
+			"nothing to run",
+			"package main\n\nfunc helper() int { return 1 }\n",
+			"package main\n\nfunc helper() int { return 1 }\n",
+		},
+		{
+			"source that does not parse",
+			"package main\n\nfunc main() {",
+			"package main\n\nfunc main() {",
+		},
+		{
+			"statements",
+			"text.New(\"Hi\")",
+			"text.New(\"Hi\")",
+		},
+		{
+			"fragment",
+			"var (\n    label = fmt.Sprint(1)\n)\n\nfunc labelWidget() application.BaseWidget {\n    return text.New(label)\n}",
+			"package main\n\nimport (\n" +
+				"\tapplication \"github.com/gofred-io/gofred/application\"\n" +
+				"\tfmt \"fmt\"\n" +
+				"\ttext \"github.com/gofred-io/gofred/foundation/text\"\n" +
+				")\n\n//line main.go:1:1\n" +
+				"var (\n    label = fmt.Sprint(1)\n)\n\nfunc labelWidget() application.BaseWidget {\n    return text.New(label)\n}" +
+				"\n\nfunc main() {\n\tapplication.Run(labelWidget())\n}\n",
+		},
+		{
+			"fragment with a variable named like a package",
+			"import \"github.com/gofred-io/gofred/application\"\n\nvar text = struct{ Value string }{}\n\nfunc w() application.BaseWidget { return icondata.Home(text.Value) }",
+			"package main\n\nimport (\n" +
+				"\ticondata \"github.com/gofred-io/gofred/foundation/icon/icon_data\"\n" +
+				")\n\n//line main.go:1:1\n" +
+				"import \"github.com/gofred-io/gofred/application\"\n\nvar text = struct{ Value string }{}\n\nfunc w() application.BaseWidget { return icondata.Home(text.Value) }" +
+				"\n\nfunc main() {\n\tapplication.Run(w())\n}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := program(tt.source, packages)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("program:\n got %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgramPackage(t *testing.T) {
+	_, err := program("// store.go\npackage store\n", nil)
+	var compileErr *CompileError
+	if !errors.As(err, &compileErr) {
+		t.Fatalf("program of package store = %v, want a CompileError", err)
+	}
+	if want := "main.go:2:9: the playground runs package main, not package store"; compileErr.Output != want {
+		t.Errorf("output = %q, want %q", compileErr.Output, want)
+	}
+}
+
+func TestIsWidgetFunc(t *testing.T) {
+	tests := []struct {
+		decl string
+		want bool
+	}{
+		{"func counter() application.BaseWidget", true},
+		{"func counter() (w application.BaseWidget)", true},
+		{"func counter(n int) application.BaseWidget", false},
+		{"func counter[T any]() application.BaseWidget", false},
+		{"func counter() (application.BaseWidget, error)", false},
+		{"func counter() other.BaseWidget", false},
+		{"func counter() application.Event", false},
+		{"func counter() BaseWidget", false},
+		{"func counter()", false},
+	}
+	for _, tt := range tests {
+		file, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+tt.decl+" { panic(0) }", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fn := file.Decls[0].(*ast.FuncDecl)
+		if got := isWidgetFunc(fn, "application"); got != tt.want {
+			t.Errorf("isWidgetFunc(%s) = %v, want %v", tt.decl, got, tt.want)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a program")
+	}
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/site\n\ngo 1.25.0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	wasm, err := c.Compile(t.Context(), "package main\n\nfunc main() {}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(wasm), "\x00asm") {
+		t.Errorf("Compile returned %d bytes that are not WebAssembly", len(wasm))
+	}
+
+	// Errors of fragments are at their lines
+	_, err = c.Compile(t.Context(), "var n = strings.Repeat(\"a\")\n")
+	var compileErr *CompileError
+	if !errors.As(err, &compileErr) {
+		t.Fatalf("Compile of a broken fragment = %v, want a CompileError", err)
+	}
+	if !strings.HasPrefix(compileErr.Output, "main.go:1:") {
+		t.Errorf("output = %q, want an error at main.go:1", compileErr.Output)
+	}
+}
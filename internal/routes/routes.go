@@ -0,0 +1,443 @@
+// Package routes reads the site's routes and docs registry from the Go sources.
+//
+// The app packages only build for js/wasm, so tools running on the host parse
+// app/app.go and app/pages/docs/registry instead of importing them.
+package routes
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	appFile      = "app/app.go"
+	registryDir  = "app/pages/docs/registry"
+	registryFile = "pages.go"
+
+	// RegistryFile declares the docs categories and pages, relative to the
+	// module root
+	RegistryFile = registryDir + "/" + registryFile
+)
+
+// Category mirrors registry.Category
+type Category struct {
+	ID          string
+	Title       string
+	Description string
+	Order       int
+}
+
+// Page mirrors registry.Page. Content holds the source of the content
+// expression and is empty for coming soon pages.
+type Page struct {
+	Slug        string
+	Title       string
+	Description string
+	Category    string
+	Order       int
+	Content     string
+}
+
+// Site is everything the host tools need to know about the site's routes
+type Site struct {
+	// Patterns are the router.Route patterns in declaration order
+	Patterns []string
+
+	// Handlers maps the patterns to the functions building their page
+	Handlers map[string]Func
+
+	// DocsBase is registry.BasePath
+	DocsBase string
+
+	Categories []Category
+	Pages      []Page
+
+	// Imports maps the package names used by the registry's content
+	// expressions to their import paths
+	Imports map[string]string
+}
+
+// Func is a package level function
+type Func struct {
+	// Package is the import path of the package declaring the function
+	Package string
+	Name    string
+}
+
+// Dir returns the directory of the function's package relative to the root
+// of module, or false for packages of other modules
+func (f Func) Dir(module string) (string, bool) {
+	return strings.CutPrefix(f.Package, module+"/")
+}
+
+// Available reports whether the page has real content
+func (p Page) Available() bool {
+	return p.Content != ""
+}
+
+// Load parses the sources of the module rooted at root
+func Load(root string) (*Site, error) {
+	site := &Site{Handlers: make(map[string]Func), Imports: make(map[string]string)}
+
+	fset := token.NewFileSet()
+	appAST, err := parser.ParseFile(fset, filepath.Join(root, appFile), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	site.Patterns = site.readRoutes(appAST)
+
+	pkgFiles, err := parseDir(fset, filepath.Join(root, registryDir))
+	if err != nil {
+		return nil, err
+	}
+
+	consts := stringConsts(pkgFiles)
+	site.DocsBase = consts["BasePath"]
+	if site.DocsBase == "" {
+		return nil, fmt.Errorf("routes: BasePath not found in %s", registryDir)
+	}
+
+	for _, file := range pkgFiles {
+		if filepath.Base(fset.Position(file.Pos()).Filename) != registryFile {
+			continue
+		}
+		site.Imports = imports(file)
+		if err := site.readRegistry(fset, file, consts); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(site.Categories, func(i, j int) bool {
+		return site.Categories[i].Order < site.Categories[j].Order
+	})
+
+	return site, nil
+}
+
+// Lookup returns the docs page with the given slug
+func (s *Site) Lookup(slug string) (Page, bool) {
+	for _, page := range s.Pages {
+		if page.Slug == slug {
+			return page, true
+		}
+	}
+	return Page{}, false
+}
+
+// Href returns the route of the docs page with the given slug
+func (s *Site) Href(slug string) string {
+	if slug == "" {
+		return s.DocsBase
+	}
+	return s.DocsBase + "/" + slug
+}
+
+// LookupCategory returns the category with the given ID
+func (s *Site) LookupCategory(id string) (Category, bool) {
+	for _, category := range s.Categories {
+		if category.ID == id {
+			return category, true
+		}
+	}
+	return Category{}, false
+}
+
+// Sections returns the docs pages grouped by category, both in display order
+func (s *Site) Sections() map[string][]Page {
+	sections := make(map[string][]Page)
+	for _, page := range s.Pages {
+		sections[page.Category] = append(sections[page.Category], page)
+	}
+	for _, pages := range sections {
+		sort.SliceStable(pages, func(i, j int) bool {
+			return pages[i].Order < pages[j].Order
+		})
+	}
+	return sections
+}
+
+// Paths returns every concrete path the router serves. Patterns with a
+// parameter under the docs base path expand to the docs landing page, the
+// overview page of every category followed by its pages; other
+// parameterised patterns are skipped.
+func (s *Site) Paths() []string {
+	var paths []string
+	seen := make(map[string]bool)
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	for _, pattern := range s.Patterns {
+		if !strings.Contains(pattern, ":") {
+			add(pattern)
+			continue
+		}
+		if !strings.HasPrefix(pattern, s.DocsBase+"/:") {
+			continue
+		}
+
+		add(s.DocsBase)
+		for _, category := range s.Categories {
+			add(s.Href(category.ID))
+			for _, page := range s.Sections()[category.ID] {
+				add(s.Href(page.Slug))
+			}
+		}
+	}
+
+	return paths
+}
+
+// Has reports whether path is served by a route
+func (s *Site) Has(path string) bool {
+	for _, candidate := range s.Paths() {
+		if candidate == path {
+			return true
+		}
+	}
+	return false
+}
+
+// FindRoot walks up from dir to the directory containing go.mod
+func FindRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("routes: go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+// ModulePath returns the module path declared in root/go.mod
+func ModulePath(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("routes: no module line in go.mod")
+}
+
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, match := range matches {
+		if strings.HasSuffix(match, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, match, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// readRoutes collects the string patterns passed to router.Route and the
+// handlers they are routed to
+func (s *Site) readRoutes(file *ast.File) []string {
+	packages := imports(file)
+
+	var patterns []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || !isSelector(call.Fun, "router", "Route") || len(call.Args) == 0 {
+			return true
+		}
+		value, ok := stringLit(call.Args[0])
+		if !ok {
+			return true
+		}
+		patterns = append(patterns, value)
+
+		if len(call.Args) > 1 {
+			if handler, ok := call.Args[1].(*ast.SelectorExpr); ok {
+				if pkg, ok := handler.X.(*ast.Ident); ok && packages[pkg.Name] != "" {
+					s.Handlers[value] = Func{Package: packages[pkg.Name], Name: handler.Sel.Name}
+				}
+			}
+		}
+		return true
+	})
+	return patterns
+}
+
+// imports maps the package names of a file to their import paths
+func imports(file *ast.File) map[string]string {
+	packages := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		packages[name] = path
+	}
+	return packages
+}
+
+func stringConsts(files []*ast.File) map[string]string {
+	consts := make(map[string]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				for i, name := range valueSpec.Names {
+					if i >= len(valueSpec.Values) {
+						continue
+					}
+					if value, ok := stringLit(valueSpec.Values[i]); ok {
+						consts[name.Name] = value
+					}
+				}
+			}
+		}
+	}
+	return consts
+}
+
+// readRegistry reads the categories and pages tables
+func (s *Site) readRegistry(fset *token.FileSet, file *ast.File, consts map[string]string) error {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					continue
+				}
+				list, ok := valueSpec.Values[i].(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+
+				for _, elt := range list.Elts {
+					entry, ok := elt.(*ast.CompositeLit)
+					if !ok {
+						continue
+					}
+					fields, err := entryFields(fset, entry, consts)
+					if err != nil {
+						return err
+					}
+
+					switch name.Name {
+					case "categories":
+						s.Categories = append(s.Categories, Category{
+							ID:          fields["ID"],
+							Title:       fields["Title"],
+							Description: fields["Description"],
+							Order:       atoi(fields["Order"]),
+						})
+					case "pages":
+						s.Pages = append(s.Pages, Page{
+							Slug:        fields["Slug"],
+							Title:       fields["Title"],
+							Description: fields["Description"],
+							Category:    fields["Category"],
+							Order:       atoi(fields["Order"]),
+							Content:     fields["Content"],
+						})
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// entryFields returns the keyed fields of a composite literal as strings.
+// Constants are resolved and other expressions are returned as source.
+func entryFields(fset *token.FileSet, entry *ast.CompositeLit, consts map[string]string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, elt := range entry.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("routes: %s: unkeyed field", fset.Position(elt.Pos()))
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch value := kv.Value.(type) {
+		case *ast.BasicLit:
+			if value.Kind == token.STRING {
+				fields[key.Name], _ = strconv.Unquote(value.Value)
+			} else {
+				fields[key.Name] = value.Value
+			}
+		case *ast.Ident:
+			if resolved, ok := consts[value.Name]; ok {
+				fields[key.Name] = resolved
+			} else {
+				fields[key.Name] = value.Name
+			}
+		default:
+			start, end := fset.Position(value.Pos()), fset.Position(value.End())
+			source, err := os.ReadFile(start.Filename)
+			if err != nil {
+				return nil, err
+			}
+			fields[key.Name] = string(source[start.Offset:end.Offset])
+		}
+	}
+	return fields, nil
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != name {
+		return false
+	}
+	ident, ok := selector.X.(*ast.Ident)
+	return ok && ident.Name == pkg
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
+
+func atoi(value string) int {
+	n, _ := strconv.Atoi(value)
+	return n
+}
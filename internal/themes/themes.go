@@ -0,0 +1,388 @@
+// Package themes reads the colours of the registered themes from the Go
+// sources of app/theme and the theme files it embeds.
+//
+// Like internal/routes it parses instead of importing, app/theme only builds
+// for js/wasm. Themes registered outside app/theme are not seen.
+package themes
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofred-io/gofred-website/app/theme/themefile"
+)
+
+const (
+	// Dir is the theme package, relative to the module root
+	Dir = "app/theme"
+
+	// FilesDir holds the embedded theme files
+	FilesDir = Dir + "/themes"
+
+	lightTheme = "light"
+	darkTheme  = "dark"
+)
+
+// Color is a colour as 0xRRGGBBAA
+type Color = themefile.Color
+
+// Theme is a registered theme. Colors maps the dotted ThemeData field of
+// every colour, like "TextTheme.TextStyle.Primary.Color", to its value.
+type Theme struct {
+	Name  string
+	Title string
+	Dark  bool
+
+	// File declares the theme data, a Go file or a theme file
+	File string
+
+	Colors map[string]Color
+}
+
+// Load returns the themes in registration order: the theme.Register calls of
+// app/theme, then the theme files in name order
+func Load(root string) ([]Theme, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(root, Dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	p := goPackage{fset: fset, consts: make(map[string]string), vars: make(map[string]ast.Expr)}
+	parsed := make(map[string]*ast.File)
+	for _, name := range files {
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		parsed[name] = file
+		p.declarations(file)
+	}
+
+	var themes []Theme
+	for _, name := range files {
+		var inspectErr error
+		ast.Inspect(parsed[name], func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || inspectErr != nil {
+				return inspectErr == nil
+			}
+			if fn, ok := call.Fun.(*ast.Ident); !ok || fn.Name != "Register" || len(call.Args) != 1 {
+				return true
+			}
+			info, ok := call.Args[0].(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			theme, err := p.register(info)
+			if err != nil {
+				inspectErr = fmt.Errorf("%s: %w", fset.Position(call.Pos()), err)
+				return false
+			}
+			themes = append(themes, theme)
+			return true
+		})
+		if inspectErr != nil {
+			return nil, inspectErr
+		}
+	}
+
+	// Like theme.Register, themes without tokens or syntax colours get the
+	// ones of the light or dark theme
+	base := make(map[string]map[string]Color)
+	for _, theme := range themes {
+		base[theme.Name] = theme.Colors
+	}
+	for _, theme := range themes {
+		from := base[lightTheme]
+		if theme.Dark {
+			from = base[darkTheme]
+		}
+		for _, prefix := range []string{"Tokens.", "Syntax."} {
+			if hasPrefix(theme.Colors, prefix) {
+				continue
+			}
+			for path, c := range from {
+				if strings.HasPrefix(path, prefix) {
+					theme.Colors[path] = c
+				}
+			}
+		}
+	}
+
+	fromFiles, err := loadFiles(root, base)
+	if err != nil {
+		return nil, err
+	}
+	return append(themes, fromFiles...), nil
+}
+
+type goPackage struct {
+	fset   *token.FileSet
+	consts map[string]string
+	vars   map[string]ast.Expr
+}
+
+// declarations records the string constants and package variables of file
+func (p *goPackage) declarations(file *ast.File) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range value.Names {
+				if i >= len(value.Values) {
+					break
+				}
+				switch gen.Tok {
+				case token.CONST:
+					if lit, ok := value.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						p.consts[name.Name], _ = strconv.Unquote(lit.Value)
+					}
+				case token.VAR:
+					p.vars[name.Name] = value.Values[i]
+				}
+			}
+		}
+	}
+}
+
+// register reads a theme.Info literal
+func (p *goPackage) register(info *ast.CompositeLit) (Theme, error) {
+	var theme Theme
+	var data string
+	// literals are the Tokens and Syntax variables by field
+	literals := make(map[string]string)
+	for _, elt := range info.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, _ := kv.Key.(*ast.Ident)
+		if key == nil {
+			continue
+		}
+		switch key.Name {
+		case "Name":
+			theme.Name = p.constant(kv.Value)
+		case "Title":
+			theme.Title = p.constant(kv.Value)
+		case "Dark":
+			ident, ok := kv.Value.(*ast.Ident)
+			theme.Dark = ok && ident.Name == "true"
+		case "Data":
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				data = ident.Name
+			}
+		case "Tokens", "Syntax":
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				literals[key.Name] = ident.Name
+			}
+		}
+	}
+
+	value, ok := p.vars[data]
+	if theme.Name == "" || !ok {
+		return Theme{}, fmt.Errorf("theme.Info needs a constant Name and a package variable as Data")
+	}
+	if unary, ok := value.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		value = unary.X
+	}
+	lit, ok := value.(*ast.CompositeLit)
+	if !ok {
+		return Theme{}, fmt.Errorf("%s is not a ThemeData literal", data)
+	}
+
+	theme.File = p.fset.Position(lit.Pos()).Filename
+	theme.Colors = make(map[string]Color)
+	if err := p.colors(lit, "", theme.Colors); err != nil {
+		return Theme{}, err
+	}
+	for field, name := range literals {
+		if err := p.hexStrings(field, name, theme.Colors); err != nil {
+			return Theme{}, err
+		}
+	}
+	return theme, nil
+}
+
+// hexStrings records the hex strings of the Tokens or Syntax literal in the
+// variable name under "Tokens.Field" or "Syntax.Field"
+func (p *goPackage) hexStrings(field, name string, colors map[string]Color) error {
+	lit, ok := p.vars[name].(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("%s is not a %s literal", name, field)
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		value, isLit := kv.Value.(*ast.BasicLit)
+		if !ok || !isLit || value.Kind != token.STRING {
+			return fmt.Errorf("%s: %s fields must be string literals", p.fset.Position(elt.Pos()), name)
+		}
+		text, _ := strconv.Unquote(value.Value)
+		c, err := themefile.ParseColor(text)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p.fset.Position(value.Pos()), err)
+		}
+		colors[field+"."+key.Name] = c
+	}
+	return nil
+}
+
+// constant returns the value of a string literal or constant, also wrapped
+// in a conversion like string(ThemeLight)
+func (p *goPackage) constant(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		value, _ := strconv.Unquote(expr.Value)
+		return value
+	case *ast.Ident:
+		return p.consts[expr.Name]
+	case *ast.CallExpr:
+		if len(expr.Args) == 1 {
+			return p.constant(expr.Args[0])
+		}
+	}
+	return ""
+}
+
+// colors records the color.From literals of a ThemeData literal under the
+// dotted path of their field
+func (p *goPackage) colors(lit *ast.CompositeLit, at string, colors map[string]Color) error {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		path := key.Name
+		if at != "" {
+			path = at + "." + key.Name
+		}
+
+		if nested, ok := kv.Value.(*ast.CompositeLit); ok {
+			if err := p.colors(nested, path, colors); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var from *ast.CallExpr
+		ast.Inspect(kv.Value, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && isColorFrom(call) {
+				from = call
+			}
+			return from == nil
+		})
+		if from == nil {
+			continue
+		}
+		lit, ok := from.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return fmt.Errorf("%s: %s is not a hex literal", p.fset.Position(from.Pos()), path)
+		}
+		value, err := strconv.ParseUint(lit.Value, 0, 32)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", p.fset.Position(lit.Pos()), path, err)
+		}
+		colors[path] = Color(value)
+	}
+	return nil
+}
+
+func isColorFrom(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "From" || len(call.Args) != 1 {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "color"
+}
+
+// loadFiles reads the theme files, filling in the colours they leave out
+// from the light or dark theme of base the way theme.Load does
+func loadFiles(root string, base map[string]map[string]Color) ([]Theme, error) {
+	entries, err := os.ReadDir(filepath.Join(root, FilesDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var themes []Theme
+	for _, entry := range entries {
+		path := filepath.Join(root, FilesDir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		file, err := themefile.Parse(path, data)
+		if err != nil {
+			return nil, err
+		}
+
+		colors := make(map[string]Color)
+		from := base[lightTheme]
+		if file.Dark {
+			from = base[darkTheme]
+		}
+		for path, c := range from {
+			colors[path] = c
+		}
+		fileColors(reflect.ValueOf(file).Elem(), "", colors)
+
+		themes = append(themes, Theme{Name: file.Name, Title: file.Title, Dark: file.Dark, File: path, Colors: colors})
+	}
+	return themes, nil
+}
+
+// fileColors records the colours set in a themefile struct. The field names
+// of package themefile are the ones of ThemeData.
+func fileColors(value reflect.Value, at string, colors map[string]Color) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.Pointer || field.IsNil() {
+			continue
+		}
+		path := strings.TrimPrefix(at+"."+value.Type().Field(i).Name, ".")
+		switch c := field.Interface().(type) {
+		case *Color:
+			colors[path] = *c
+		default:
+			if field.Elem().Kind() == reflect.Struct {
+				fileColors(field.Elem(), path, colors)
+			}
+		}
+	}
+}
+
+func hasPrefix(colors map[string]Color, prefix string) bool {
+	for path := range colors {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -11,29 +11,60 @@ function createWebsocketClient() {
     return;
   }
 
-  const socket = new WebSocket(`ws://localhost:${livePort}/ws`);
+  const socket = new WebSocket(`ws://${window.location.hostname}:${livePort}/ws`);
   socket.onmessage = (event) => {
     const msg = JSON.parse(event.data);
     if (msg.cmd === "reload") {
       window.location.reload();
+    } else if (msg.cmd === "error") {
+      showBuildError(msg.output);
     }
   }
   socket.onopen = () => {
   }
   socket.onclose = () => {
     console.log("disconnected from websocket server, reconnecting...");
-    createWebsocketClient();
+    setTimeout(createWebsocketClient, 1000);
   }
   socket.onerror = (event) => {
     console.log("error: ", event);
   }
 }
 
+// Show the compiler output of a failed build over the page until the next
+// successful build reloads it
+function showBuildError(output) {
+  let overlay = document.getElementById('build-error');
+  if (!overlay) {
+    overlay = document.createElement('div');
+    overlay.id = 'build-error';
+    overlay.className = 'build-error';
+
+    const title = document.createElement('div');
+    title.className = 'build-error-title';
+    title.textContent = 'Build failed';
+    overlay.append(title, document.createElement('pre'));
+    document.body.append(overlay);
+  }
+  overlay.querySelector('pre').textContent = output;
+}
+
+// Connect before the WebAssembly loads, so a page opened while the build is
+// broken still gets the error
+createWebsocketClient();
+
 // A function to run after WebAssembly is instantiated.
 function postInstantiate(obj) {
   wasm = obj.instance;
+
+  // Drop the page cmd/prerender rendered, the app renders it again
+  const root = document.getElementById('root');
+  if (root?.hasAttribute('data-prerender')) {
+    root.replaceChildren();
+    root.removeAttribute('data-prerender');
+    document.getElementById('prerender-style')?.remove();
+  }
   go.run(wasm);
-  createWebsocketClient();
 
   customElements.define('pushstate-anchor', HTMLPushStateAnchorElement, { extends: 'a' });
 }